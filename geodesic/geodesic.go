@@ -0,0 +1,1026 @@
+// Package geodesic measures geometries with longitude and latitude in degrees, such as SRID 4326,
+// in meters on surface of ellipsoid or sphere.
+//
+// Points use X as longitude and Y as latitude. Bearings are in degrees clockwise from north in [0, 360).
+// Ellipsoid follows C. F. F. Karney, Algorithms for geodesics, J. Geodesy 87, 43-55 (2013),
+// accurate to nanometers, while Sphere uses haversine formulas and is faster but off by up to 0.5%.
+package geodesic
+
+import (
+	"math"
+
+	"github.com/shaxbee/go-spatialite/wkb"
+)
+
+// Ellipsoid is ellipsoid of revolution.
+type Ellipsoid struct {
+	a, f, f1, e2, ep2, n, b, c2, etol2 float64
+
+	a3x [nA3]float64
+	c3x [nC3x]float64
+	c4x [nC4x]float64
+}
+
+// WGS84 is ellipsoid of World Geodetic System 1984, used by GPS and SRID 4326.
+var WGS84 = NewEllipsoid(6378137, 1/298.257223563)
+
+// Distance returns geodesic distance between points on WGS84.
+func Distance(p1, p2 wkb.Point) float64 {
+	return WGS84.Distance(p1, p2)
+}
+
+const (
+	nA1  = 6
+	nC1  = 6
+	nC1p = 6
+	nA2  = 6
+	nC2  = 6
+	nA3  = 6
+	nC3  = 6
+	nC4  = 6
+	nC3x = nC3 * (nC3 - 1) / 2
+	nC4x = nC4 * (nC4 + 1) / 2
+
+	maxit1 = 20
+	maxit2 = maxit1 + 53 + 10
+)
+
+var (
+	tiny    = math.Sqrt(math.SmallestNonzeroFloat64 * (1 << 52))
+	tol0    = math.Nextafter(1, 2) - 1
+	tol1    = 200 * tol0
+	tol2    = math.Sqrt(tol0)
+	tolb    = tol0 * tol2
+	xthresh = 1000 * tol2
+)
+
+// NewEllipsoid returns ellipsoid with equatorial radius a in meters and flattening f.
+func NewEllipsoid(a, f float64) *Ellipsoid {
+	e := &Ellipsoid{a: a, f: f}
+	e.f1 = 1 - f
+	e.e2 = f * (2 - f)
+	e.ep2 = e.e2 / (e.f1 * e.f1)
+	e.n = f / (2 - f)
+	e.b = a * e.f1
+
+	switch {
+	case e.e2 > 0:
+		e.c2 = (a*a + e.b*e.b*math.Atanh(math.Sqrt(e.e2))/math.Sqrt(e.e2)) / 2
+	case e.e2 < 0:
+		e.c2 = (a*a + e.b*e.b*math.Atan(math.Sqrt(-e.e2))/math.Sqrt(-e.e2)) / 2
+	default:
+		e.c2 = a * a
+	}
+	e.etol2 = 0.1 * tol2 / math.Sqrt(math.Max(0.001, math.Abs(f))*math.Min(1, 1-f/2)/2)
+
+	e.a3coeff()
+	e.c3coeff()
+	e.c4coeff()
+	return e
+}
+
+// Inverse returns distance between points and bearings of geodesic at both of them.
+func (e *Ellipsoid) Inverse(p1, p2 wkb.Point) (distance, bearing1, bearing2 float64) {
+	s12, salp1, calp1, salp2, calp2, _ := e.inverse(p1.Y, p1.X, p2.Y, p2.X, false)
+	return s12, bearing(salp1, calp1), bearing(salp2, calp2)
+}
+
+// Direct returns point at distance from p following geodesic with initial bearing, and bearing of geodesic there.
+func (e *Ellipsoid) Direct(p wkb.Point, bearing, distance float64) (wkb.Point, float64) {
+	lat2, lon2, azi2 := e.direct(p.Y, p.X, bearing, distance)
+	return wkb.Point{X: lon2, Y: lat2}, normalizeBearing(azi2)
+}
+
+// Distance returns length of shortest geodesic between points.
+func (e *Ellipsoid) Distance(p1, p2 wkb.Point) float64 {
+	s12, _, _, _, _, _ := e.inverse(p1.Y, p1.X, p2.Y, p2.X, false)
+	return s12
+}
+
+// InitialBearing returns bearing at p1 of geodesic from p1 to p2.
+func (e *Ellipsoid) InitialBearing(p1, p2 wkb.Point) float64 {
+	_, b, _ := e.Inverse(p1, p2)
+	return b
+}
+
+// FinalBearing returns bearing at p2 of geodesic from p1 to p2.
+func (e *Ellipsoid) FinalBearing(p1, p2 wkb.Point) float64 {
+	_, _, b := e.Inverse(p1, p2)
+	return b
+}
+
+// Destination returns point at distance from p following geodesic with initial bearing.
+func (e *Ellipsoid) Destination(p wkb.Point, bearing, distance float64) wkb.Point {
+	d, _ := e.Direct(p, bearing, distance)
+	return d
+}
+
+// Length returns geodesic length of line string.
+func (e *Ellipsoid) Length(ls wkb.LineString) float64 {
+	length := 0.0
+	for i := 1; i < len(ls); i++ {
+		length += e.Distance(ls[i-1], ls[i])
+	}
+	return length
+}
+
+// Area returns area of polygon with geodesic edges, exterior ring less interior rings.
+// Orientation of rings does not matter, area of ring is the smaller one of two it divides surface into.
+func (e *Ellipsoid) Area(p wkb.Polygon) float64 {
+	area := 0.0
+	for i, r := range p {
+		if i == 0 {
+			area += e.ringArea(r)
+		} else {
+			area -= e.ringArea(r)
+		}
+	}
+	return area
+}
+
+func (e *Ellipsoid) ringArea(r wkb.LinearRing) float64 {
+	if len(r) < 3 {
+		return 0
+	}
+
+	sum := 0.0
+	crossings := 0
+	edge := func(p1, p2 wkb.Point) {
+		_, _, _, _, _, s12 := e.inverse(p1.Y, p1.X, p2.Y, p2.X, true)
+		sum += s12
+		crossings += transit(p1.X, p2.X)
+	}
+	for i := 1; i < len(r); i++ {
+		edge(r[i-1], r[i])
+	}
+	if first, last := r[0], r[len(r)-1]; first != last {
+		edge(last, first)
+	}
+
+	return reduceArea(sum, 4*math.Pi*e.c2, crossings)
+}
+
+// reduceArea returns unsigned area of ring from sum of signed areas between its edges and equator.
+// Ring encircling pole crosses prime meridian odd number of times and is off by half of total area.
+func reduceArea(sum, total float64, crossings int) float64 {
+	sum = math.Remainder(sum, total)
+	if crossings&1 != 0 {
+		if sum < 0 {
+			sum += total / 2
+		} else {
+			sum -= total / 2
+		}
+	}
+	if sum > total/2 {
+		sum -= total
+	} else if sum <= -total/2 {
+		sum += total
+	}
+	return math.Abs(sum)
+}
+
+// transit returns 1 or -1 when edge crosses prime meridian going east or west, 0 otherwise.
+func transit(lon1, lon2 float64) int {
+	lon12, _ := angDiff(lon1, lon2)
+	lon1, lon2 = angNormalize(lon1), angNormalize(lon2)
+	switch {
+	case lon12 > 0 && (lon1 < 0 && lon2 >= 0 || lon1 > 0 && lon2 == 0):
+		return 1
+	case lon12 < 0 && lon1 >= 0 && lon2 < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// inverse solves inverse geodesic problem, returning distance, sines and cosines of azimuths at both points
+// and area between geodesic and equator when requested.
+func (e *Ellipsoid) inverse(lat1, lon1, lat2, lon2 float64, area bool) (s12, salp1, calp1, salp2, calp2, S12 float64) {
+	var s12x, m12x, sig12 float64
+	omg12, somg12, comg12 := 0.0, 2.0, 0.0
+
+	// bring points to canonical form: 0 <= lon12 <= 180, -90 <= lat1 <= 0, lat1 <= lat2 <= -lat1
+	lon12, lon12s := angDiff(lon1, lon2)
+	lonsign := 1.0
+	if lon12 < 0 {
+		lonsign = -1
+	}
+	lon12 = lonsign * angRound(lon12)
+	lon12s = angRound((180 - lon12) - lonsign*lon12s)
+	lam12 := lon12 * math.Pi / 180
+	var slam12, clam12 float64
+	if lon12 > 90 {
+		slam12, clam12 = sincosd(lon12s)
+		clam12 = -clam12
+	} else {
+		slam12, clam12 = sincosd(lon12)
+	}
+
+	lat1, lat2 = angRound(latFix(lat1)), angRound(latFix(lat2))
+	swapp := 1.0
+	if math.Abs(lat1) < math.Abs(lat2) {
+		swapp = -1
+		lonsign *= -1
+		lat1, lat2 = lat2, lat1
+	}
+	latsign := -1.0
+	if math.Signbit(lat1) {
+		latsign = 1
+	}
+	lat1 *= latsign
+	lat2 *= latsign
+
+	sbet1, cbet1 := sincosd(lat1)
+	sbet1 *= e.f1
+	sbet1, cbet1 = norm2(sbet1, cbet1)
+	cbet1 = math.Max(tiny, cbet1)
+
+	sbet2, cbet2 := sincosd(lat2)
+	sbet2 *= e.f1
+	sbet2, cbet2 = norm2(sbet2, cbet2)
+	cbet2 = math.Max(tiny, cbet2)
+
+	// force bet2 = ±bet1 when their difference vanishes
+	if cbet1 < -sbet1 {
+		if cbet2 == cbet1 {
+			if sbet2 < 0 {
+				sbet2 = sbet1
+			} else {
+				sbet2 = -sbet1
+			}
+		}
+	} else if math.Abs(sbet2) == -sbet1 {
+		cbet2 = cbet1
+	}
+
+	dn1 := math.Sqrt(1 + e.ep2*sbet1*sbet1)
+	dn2 := math.Sqrt(1 + e.ep2*sbet2*sbet2)
+
+	meridian := lat1 == -90 || slam12 == 0
+	if meridian {
+		// endpoints are on single full meridian, geodesic might follow it
+		calp1, salp1 = clam12, slam12
+		calp2, salp2 = 1, 0
+
+		ssig1, csig1 := sbet1, calp1*cbet1
+		ssig2, csig2 := sbet2, calp2*cbet2
+		sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+		s12x, m12x, _ = e.lengths(e.n, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2)
+
+		if sig12 < 1 || m12x >= 0 {
+			if sig12 < 3*tiny || sig12 < tol0 && (s12x < 0 || m12x < 0) {
+				sig12, m12x, s12x = 0, 0, 0
+			}
+			m12x *= e.b
+			s12x *= e.b
+		} else {
+			// prolate and too close to antipodal
+			meridian = false
+		}
+	}
+
+	switch {
+	case !meridian && sbet1 == 0 && (e.f <= 0 || lon12s >= e.f*180):
+		// geodesic runs along equator
+		calp1, calp2, salp1, salp2 = 0, 0, 1, 1
+		s12x = e.a * lam12
+		sig12 = lam12 / e.f1
+		omg12 = sig12
+		m12x = e.b * math.Sin(sig12)
+	case !meridian:
+		var dnm float64
+		sig12, salp1, calp1, salp2, calp2, dnm = e.inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12)
+
+		if sig12 >= 0 {
+			// short lines
+			s12x = sig12 * e.b * dnm
+			m12x = dnm * dnm * e.b * math.Sin(sig12/dnm)
+			omg12 = lam12 / (e.f1 * dnm)
+		} else {
+			// Newton's method on lambda12(alp1) - lam12, keeping bracket of root to fall back to bisection
+			var ssig1, csig1, ssig2, csig2, eps, domg12 float64
+			salp1a, calp1a, salp1b, calp1b := tiny, 1.0, tiny, -1.0
+			tripn, tripb := false, false
+			for numit := 0; ; numit++ {
+				var v, dv float64
+				v, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, domg12, dv = e.lambda12(
+					sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam12, clam12, numit < maxit1)
+
+				tol := tol0
+				if tripn {
+					tol *= 8
+				}
+				if tripb || !(math.Abs(v) >= tol) || numit == maxit2 {
+					break
+				}
+
+				if v > 0 && (numit > maxit1 || calp1/salp1 > calp1b/salp1b) {
+					salp1b, calp1b = salp1, calp1
+				} else if v < 0 && (numit > maxit1 || calp1/salp1 < calp1a/salp1a) {
+					salp1a, calp1a = salp1, calp1
+				}
+
+				if numit < maxit1 && dv > 0 {
+					dalp1 := -v / dv
+					if math.Abs(dalp1) < math.Pi {
+						sdalp1, cdalp1 := math.Sincos(dalp1)
+						if nsalp1 := salp1*cdalp1 + calp1*sdalp1; nsalp1 > 0 {
+							calp1 = calp1*cdalp1 - salp1*sdalp1
+							salp1 = nsalp1
+							salp1, calp1 = norm2(salp1, calp1)
+							tripn = math.Abs(v) <= 16*tol0
+							continue
+						}
+					}
+				}
+
+				salp1, calp1 = norm2((salp1a+salp1b)/2, (calp1a+calp1b)/2)
+				tripn = false
+				tripb = math.Abs(salp1a-salp1)+(calp1a-calp1) < tolb ||
+					math.Abs(salp1-salp1b)+(calp1-calp1b) < tolb
+			}
+
+			s12x, m12x, _ = e.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2)
+			m12x *= e.b
+			s12x *= e.b
+
+			sdomg12, cdomg12 := math.Sincos(domg12)
+			somg12 = slam12*cdomg12 - clam12*sdomg12
+			comg12 = clam12*cdomg12 + slam12*sdomg12
+		}
+	}
+	s12 = s12x
+
+	if area {
+		S12 = e.area(sbet1, cbet1, sbet2, cbet2, salp1, calp1, salp2, calp2, meridian, omg12, somg12, comg12)
+		S12 *= swapp * lonsign * latsign
+	}
+
+	if swapp < 0 {
+		salp1, salp2 = salp2, salp1
+		calp1, calp2 = calp2, calp1
+	}
+	salp1 *= swapp * lonsign
+	calp1 *= swapp * latsign
+	salp2 *= swapp * lonsign
+	calp2 *= swapp * latsign
+	return s12, salp1, calp1, salp2, calp2, S12
+}
+
+// area returns area between geodesic and equator in canonical form.
+func (e *Ellipsoid) area(sbet1, cbet1, sbet2, cbet2, salp1, calp1, salp2, calp2 float64, meridian bool, omg12, somg12, comg12 float64) float64 {
+	var ca [nC4]float64
+	S12 := 0.0
+
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+	if calp0 != 0 && salp0 != 0 {
+		ssig1, csig1 := norm2(sbet1, calp1*cbet1)
+		ssig2, csig2 := norm2(sbet2, calp2*cbet2)
+		k2 := calp0 * calp0 * e.ep2
+		eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+		a4 := e.a * e.a * calp0 * salp0 * e.e2
+		e.c4f(eps, ca[:])
+		b41 := sinCosSeries(false, ssig1, csig1, ca[:])
+		b42 := sinCosSeries(false, ssig2, csig2, ca[:])
+		S12 = a4 * (b42 - b41)
+	}
+
+	if !meridian && somg12 > 1 {
+		somg12, comg12 = math.Sincos(omg12)
+	}
+
+	var alp12 float64
+	if !meridian && comg12 > -0.7071 && sbet2-sbet1 < 1.75 {
+		// tan(Gamma/2) = tan(omg12/2) * (tan(bet1/2) + tan(bet2/2)) / (1 + tan(bet1/2) * tan(bet2/2))
+		domg12, dbet1, dbet2 := 1+comg12, 1+cbet1, 1+cbet2
+		alp12 = 2 * math.Atan2(somg12*(sbet1*dbet2+sbet2*dbet1), domg12*(sbet1*sbet2+dbet1*dbet2))
+	} else {
+		salp12 := salp2*calp1 - calp2*salp1
+		calp12 := calp2*calp1 + salp2*salp1
+		if salp12 == 0 && calp12 < 0 {
+			salp12 = tiny * calp1
+			calp12 = -1
+		}
+		alp12 = math.Atan2(salp12, calp12)
+	}
+	return S12 + e.c2*alp12
+}
+
+// inverseStart returns starting point for Newton's method, sig12 is non-negative
+// when line is short enough to be solved directly.
+func (e *Ellipsoid) inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12 float64) (sig12, salp1, calp1, salp2, calp2, dnm float64) {
+	sig12 = -1
+	sbet12 := sbet2*cbet1 - cbet2*sbet1
+	cbet12 := cbet2*cbet1 + sbet2*sbet1
+	sbet12a := sbet2*cbet1 + cbet2*sbet1
+	shortline := cbet12 >= 0 && sbet12 < 0.5 && cbet2*lam12 < 0.5
+
+	var somg12, comg12 float64
+	if shortline {
+		sbetm2 := (sbet1 + sbet2) * (sbet1 + sbet2)
+		sbetm2 /= sbetm2 + (cbet1+cbet2)*(cbet1+cbet2)
+		dnm = math.Sqrt(1 + e.ep2*sbetm2)
+		somg12, comg12 = math.Sincos(lam12 / (e.f1 * dnm))
+	} else {
+		somg12, comg12 = slam12, clam12
+	}
+
+	salp1 = cbet2 * somg12
+	if comg12 >= 0 {
+		calp1 = sbet12 + cbet2*sbet1*somg12*somg12/(1+comg12)
+	} else {
+		calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+	}
+
+	ssig12 := math.Hypot(salp1, calp1)
+	csig12 := sbet1*sbet2 + cbet1*cbet2*comg12
+
+	switch {
+	case shortline && ssig12 < e.etol2:
+		// really short lines
+		salp2 = cbet1 * somg12
+		if comg12 >= 0 {
+			calp2 = sbet12 - cbet1*sbet2*somg12*somg12/(1+comg12)
+		} else {
+			calp2 = sbet12 - cbet1*sbet2*(1-comg12)
+		}
+		salp2, calp2 = norm2(salp2, calp2)
+		sig12 = math.Atan2(ssig12, csig12)
+	case math.Abs(e.n) > 0.1 || csig12 >= 0 || ssig12 >= 6*math.Abs(e.n)*math.Pi*cbet1*cbet1:
+		// zeroth order spherical approximation is good enough
+	default:
+		// scale lam12 and bet2 to coordinates where antipodal point is at origin
+		var x, y, lamscale, betscale float64
+		lam12x := math.Atan2(-slam12, -clam12)
+		if e.f >= 0 {
+			k2 := sbet1 * sbet1 * e.ep2
+			eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+			lamscale = e.f * cbet1 * e.a3f(eps) * math.Pi
+			betscale = lamscale * cbet1
+			x = lam12x / lamscale
+			y = sbet12a / betscale
+		} else {
+			cbet12a := cbet2*cbet1 - sbet2*sbet1
+			bet12a := math.Atan2(sbet12a, cbet12a)
+			_, m12b, m0 := e.lengths(e.n, math.Pi+bet12a, sbet1, -cbet1, dn1, sbet2, cbet2, dn2)
+			x = -1 + m12b/(cbet1*cbet2*m0*math.Pi)
+			if x < -0.01 {
+				betscale = sbet12a / x
+			} else {
+				betscale = -e.f * cbet1 * cbet1 * math.Pi
+			}
+			lamscale = betscale / cbet1
+			y = lam12x / lamscale
+		}
+
+		if y > -tol1 && x > -1-xthresh {
+			// strip near cut
+			if e.f >= 0 {
+				salp1 = math.Min(1, -x)
+				calp1 = -math.Sqrt(1 - salp1*salp1)
+			} else {
+				if x > -tol1 {
+					calp1 = math.Max(0, x)
+				} else {
+					calp1 = math.Max(-1, x)
+				}
+				salp1 = math.Sqrt(1 - calp1*calp1)
+			}
+		} else {
+			// estimate omg12 by solving astroid problem, near pi so work with pi - omg12
+			k := astroid(x, y)
+			var omg12a float64
+			if e.f >= 0 {
+				omg12a = lamscale * (-x * k / (1 + k))
+			} else {
+				omg12a = lamscale * (-y * (1 + k) / k)
+			}
+			somg12, comg12 = math.Sincos(omg12a)
+			comg12 = -comg12
+			salp1 = cbet2 * somg12
+			calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+		}
+	}
+
+	// backwards check lets NaN through
+	if !(salp1 <= 0) {
+		salp1, calp1 = norm2(salp1, calp1)
+	} else {
+		salp1, calp1 = 1, 0
+	}
+	return sig12, salp1, calp1, salp2, calp2, dnm
+}
+
+// lambda12 returns longitude difference of geodesic leaving first point with azimuth alp1,
+// less target lam12, and its derivative with respect to alp1 when diffp is set.
+func (e *Ellipsoid) lambda12(sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam120, clam120 float64, diffp bool) (
+	lam12, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, domg12, dlam12 float64) {
+	var ca [nC3]float64
+
+	if sbet1 == 0 && calp1 == 0 {
+		// break degeneracy of equatorial line
+		calp1 = -tiny
+	}
+
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	ssig1 = sbet1
+	somg1 := salp0 * sbet1
+	csig1 = calp1 * cbet1
+	comg1 := csig1
+	ssig1, csig1 = norm2(ssig1, csig1)
+
+	// enforce symmetries when abs(bet2) = -bet1
+	if cbet2 != cbet1 {
+		salp2 = salp0 / cbet2
+	} else {
+		salp2 = salp1
+	}
+	if cbet2 != cbet1 || math.Abs(sbet2) != -sbet1 {
+		t := (sbet1 - sbet2) * (sbet1 + sbet2)
+		if cbet1 < -sbet1 {
+			t = (cbet2 - cbet1) * (cbet1 + cbet2)
+		}
+		calp2 = math.Sqrt(calp1*cbet1*calp1*cbet1+t) / cbet2
+	} else {
+		calp2 = math.Abs(calp1)
+	}
+
+	ssig2 = sbet2
+	somg2 := salp0 * sbet2
+	csig2 = calp2 * cbet2
+	comg2 := csig2
+	ssig2, csig2 = norm2(ssig2, csig2)
+
+	sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+	somg12 := math.Max(0, comg1*somg2-somg1*comg2)
+	comg12 := comg1*comg2 + somg1*somg2
+	eta := math.Atan2(somg12*clam120-comg12*slam120, comg12*clam120+somg12*slam120)
+
+	k2 := calp0 * calp0 * e.ep2
+	eps = k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+	e.c3f(eps, ca[:])
+	b312 := sinCosSeries(true, ssig2, csig2, ca[:nC3]) - sinCosSeries(true, ssig1, csig1, ca[:nC3])
+	domg12 = -e.f * e.a3f(eps) * salp0 * (sig12 + b312)
+	lam12 = eta + domg12
+
+	if diffp {
+		if calp2 == 0 {
+			dlam12 = -2 * e.f1 * dn1 / sbet1
+		} else {
+			_, dlam12, _ = e.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2)
+			dlam12 *= e.f1 / (calp2 * cbet2)
+		}
+	}
+	return lam12, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, domg12, dlam12
+}
+
+// lengths returns distance and reduced length divided by b, and coefficient m0 of secular term of reduced length.
+func (e *Ellipsoid) lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2 float64) (s12b, m12b, m0 float64) {
+	var ca [nC1 + 1]float64
+	var cb [nC2 + 1]float64
+
+	a1 := a1m1f(eps)
+	c1f(eps, ca[:])
+	a2 := a2m1f(eps)
+	c2f(eps, cb[:])
+	m0 = a1 - a2
+	a1, a2 = 1+a1, 1+a2
+
+	b1 := sinCosSeries(true, ssig2, csig2, ca[:]) - sinCosSeries(true, ssig1, csig1, ca[:])
+	s12b = a1 * (sig12 + b1)
+
+	b2 := sinCosSeries(true, ssig2, csig2, cb[:]) - sinCosSeries(true, ssig1, csig1, cb[:])
+	j12 := m0*sig12 + (a1*b1 - a2*b2)
+	m12b = dn2*(csig1*ssig2) - dn1*(ssig1*csig2) - csig1*csig2*j12
+	return s12b, m12b, m0
+}
+
+// direct solves direct geodesic problem, returning latitude, longitude and azimuth at destination.
+func (e *Ellipsoid) direct(lat1, lon1, azi1, s12 float64) (lat2, lon2, azi2 float64) {
+	var c1a [nC1 + 1]float64
+	var c1pa [nC1p + 1]float64
+	var c3a [nC3]float64
+
+	salp1, calp1 := sincosd(angRound(angNormalize(azi1)))
+	sbet1, cbet1 := sincosd(angRound(latFix(lat1)))
+	sbet1 *= e.f1
+	sbet1, cbet1 = norm2(sbet1, cbet1)
+	cbet1 = math.Max(tiny, cbet1)
+
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	ssig1, somg1 := sbet1, salp0*sbet1
+	csig1 := 1.0
+	if sbet1 != 0 || calp1 != 0 {
+		csig1 = cbet1 * calp1
+	}
+	comg1 := csig1
+	ssig1, csig1 = norm2(ssig1, csig1)
+
+	k2 := calp0 * calp0 * e.ep2
+	eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+
+	a1m1 := a1m1f(eps)
+	c1f(eps, c1a[:])
+	b11 := sinCosSeries(true, ssig1, csig1, c1a[:])
+	s, c := math.Sincos(b11)
+	stau1 := ssig1*c + csig1*s
+	ctau1 := csig1*c - ssig1*s
+	c1pf(eps, c1pa[:])
+
+	e.c3f(eps, c3a[:])
+	a3c := -e.f * salp0 * e.a3f(eps)
+	b31 := sinCosSeries(true, ssig1, csig1, c3a[:])
+
+	tau12 := s12 / (e.b * (1 + a1m1))
+	s, c = math.Sincos(tau12)
+	b12 := -sinCosSeries(true, stau1*c+ctau1*s, ctau1*c-stau1*s, c1pa[:])
+	sig12 := tau12 - (b12 - b11)
+	ssig12, csig12 := math.Sincos(sig12)
+	if math.Abs(e.f) > 0.01 {
+		// reverted distance series is inaccurate for |f| > 1/100, correct with one Newton iteration
+		ssig2 := ssig1*csig12 + csig1*ssig12
+		csig2 := csig1*csig12 - ssig1*ssig12
+		b12 = sinCosSeries(true, ssig2, csig2, c1a[:])
+		serr := (1+a1m1)*(sig12+(b12-b11)) - s12/e.b
+		sig12 -= serr / math.Sqrt(1+k2*ssig2*ssig2)
+		ssig12, csig12 = math.Sincos(sig12)
+	}
+
+	ssig2 := ssig1*csig12 + csig1*ssig12
+	csig2 := csig1*csig12 - ssig1*ssig12
+	sbet2 := calp0 * ssig2
+	cbet2 := math.Hypot(salp0, calp0*csig2)
+	if cbet2 == 0 {
+		cbet2, csig2 = tiny, tiny
+	}
+	salp2, calp2 := salp0, calp0*csig2
+
+	somg2, comg2 := salp0*ssig2, csig2
+	omg12 := math.Atan2(somg2*comg1-comg2*somg1, comg2*comg1+somg2*somg1)
+	lam12 := omg12 + a3c*(sig12+(sinCosSeries(true, ssig2, csig2, c3a[:])-b31))
+	lon12 := lam12 * 180 / math.Pi
+
+	lon2 = angNormalize(angNormalize(lon1) + angNormalize(lon12))
+	lat2 = atan2d(sbet2, e.f1*cbet2)
+	azi2 = atan2d(salp2, calp2)
+	return lat2, lon2, azi2
+}
+
+func (e *Ellipsoid) a3f(eps float64) float64 {
+	return polyval(e.a3x[:], eps)
+}
+
+func (e *Ellipsoid) c3f(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 1; l < nC3; l++ {
+		m := nC3 - l - 1
+		mult *= eps
+		c[l] = mult * polyval(e.c3x[o:o+m+1], eps)
+		o += m + 1
+	}
+}
+
+func (e *Ellipsoid) c4f(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 0; l < nC4; l++ {
+		m := nC4 - l - 1
+		c[l] = mult * polyval(e.c4x[o:o+m+1], eps)
+		o += m + 1
+		mult *= eps
+	}
+}
+
+func (e *Ellipsoid) a3coeff() {
+	coeff := []float64{
+		-3, 128,
+		-2, -3, 64,
+		-1, -3, -1, 16,
+		3, -1, -2, 8,
+		1, -1, 2,
+		1, 1,
+	}
+	o, k := 0, 0
+	for j := nA3 - 1; j >= 0; j-- {
+		m := min(nA3-j-1, j)
+		e.a3x[k] = polyval(coeff[o:o+m+1], e.n) / coeff[o+m+1]
+		k++
+		o += m + 2
+	}
+}
+
+func (e *Ellipsoid) c3coeff() {
+	coeff := []float64{
+		3, 128,
+		2, 5, 128,
+		-1, 3, 3, 64,
+		-1, 0, 1, 8,
+		-1, 1, 4,
+		5, 256,
+		1, 3, 128,
+		-3, -2, 3, 64,
+		1, -3, 2, 32,
+		7, 512,
+		-10, 9, 384,
+		5, -9, 5, 192,
+		7, 512,
+		-14, 7, 512,
+		21, 2560,
+	}
+	o, k := 0, 0
+	for l := 1; l < nC3; l++ {
+		for j := nC3 - 1; j >= l; j-- {
+			m := min(nC3-j-1, j)
+			e.c3x[k] = polyval(coeff[o:o+m+1], e.n) / coeff[o+m+1]
+			k++
+			o += m + 2
+		}
+	}
+}
+
+func (e *Ellipsoid) c4coeff() {
+	coeff := []float64{
+		97, 15015,
+		1088, 156, 45045,
+		-224, -4784, 1573, 45045,
+		-10656, 14144, -4576, -858, 45045,
+		64, 624, -4576, 6864, -3003, 15015,
+		100, 208, 572, 3432, -12012, 30030, 45045,
+		1, 9009,
+		-2944, 468, 135135,
+		5792, 1040, -1287, 135135,
+		5952, -11648, 9152, -2574, 135135,
+		-64, -624, 4576, -6864, 3003, 135135,
+		8, 10725,
+		1856, -936, 225225,
+		-8448, 4992, -1144, 225225,
+		-1440, 4160, -4576, 1716, 225225,
+		-136, 63063,
+		1024, -208, 105105,
+		3584, -3328, 1144, 315315,
+		-128, 135135,
+		-2560, 832, 405405,
+		128, 99099,
+	}
+	o, k := 0, 0
+	for l := 0; l < nC4; l++ {
+		for j := nC4 - 1; j >= l; j-- {
+			m := nC4 - j - 1
+			e.c4x[k] = polyval(coeff[o:o+m+1], e.n) / coeff[o+m+1]
+			k++
+			o += m + 2
+		}
+	}
+}
+
+// a1m1f returns A1 - 1.
+func a1m1f(eps float64) float64 {
+	t := polyval([]float64{1, 4, 64, 0}, eps*eps) / 256
+	return (t + eps) / (1 - eps)
+}
+
+func c1f(eps float64, c []float64) {
+	coeff := []float64{
+		-1, 6, -16, 32,
+		-9, 64, -128, 2048,
+		9, -16, 768,
+		3, -5, 512,
+		-7, 1280,
+		-7, 2048,
+	}
+	seriesCoeff(coeff, eps, c, nC1)
+}
+
+func c1pf(eps float64, c []float64) {
+	coeff := []float64{
+		205, -432, 768, 1536,
+		4005, -4736, 3840, 12288,
+		-225, 116, 384,
+		-7173, 2695, 7680,
+		3467, 7680,
+		38081, 61440,
+	}
+	seriesCoeff(coeff, eps, c, nC1p)
+}
+
+// a2m1f returns A2 - 1.
+func a2m1f(eps float64) float64 {
+	t := polyval([]float64{-11, -28, -192, 0}, eps*eps) / 256
+	return (t - eps) / (1 + eps)
+}
+
+func c2f(eps float64, c []float64) {
+	coeff := []float64{
+		1, 2, 16, 32,
+		35, 64, 384, 2048,
+		15, 80, 768,
+		7, 35, 512,
+		63, 1280,
+		77, 2048,
+	}
+	seriesCoeff(coeff, eps, c, nC2)
+}
+
+// seriesCoeff evaluates coefficients c[1..n] of series in eps, each given as polynomial in eps² followed by divisor.
+func seriesCoeff(coeff []float64, eps float64, c []float64, n int) {
+	eps2, d := eps*eps, eps
+	o := 0
+	for l := 1; l <= n; l++ {
+		m := (n - l) / 2
+		c[l] = d * polyval(coeff[o:o+m+1], eps2) / coeff[o+m+1]
+		o += m + 2
+		d *= eps
+	}
+}
+
+// polyval evaluates polynomial with coefficients p, highest order first.
+func polyval(p []float64, x float64) float64 {
+	y := 0.0
+	for _, c := range p {
+		y = y*x + c
+	}
+	return y
+}
+
+// sinCosSeries evaluates sum of c[i] * sin(2*i*x) for i in 1..n-1 when sinp is set,
+// or sum of c[i] * cos((2*i+1)*x) for i in 0..n-1 otherwise, using Clenshaw summation.
+func sinCosSeries(sinp bool, sinx, cosx float64, c []float64) float64 {
+	n := len(c)
+	if sinp {
+		n--
+	}
+	k := len(c)
+	ar := 2 * (cosx - sinx) * (cosx + sinx)
+	y0, y1 := 0.0, 0.0
+	if n&1 != 0 {
+		k--
+		y0 = c[k]
+	}
+	for n /= 2; n > 0; n-- {
+		k--
+		y1 = ar*y0 - y1 + c[k]
+		k--
+		y0 = ar*y1 - y0 + c[k]
+	}
+	if sinp {
+		return 2 * sinx * cosx * y0
+	}
+	return cosx * (y0 - y1)
+}
+
+func astroid(x, y float64) float64 {
+	p, q := x*x, y*y
+	r := (p + q - 1) / 6
+	if q == 0 && r <= 0 {
+		return 0
+	}
+
+	s := p * q / 4
+	r2 := r * r
+	r3 := r * r2
+	disc := s * (s + 2*r3)
+	u := r
+	if disc >= 0 {
+		t3 := s + r3
+		if t3 < 0 {
+			t3 -= math.Sqrt(disc)
+		} else {
+			t3 += math.Sqrt(disc)
+		}
+		t := math.Cbrt(t3)
+		u += t
+		if t != 0 {
+			u += r2 / t
+		}
+	} else {
+		ang := math.Atan2(math.Sqrt(-disc), -(s + r3))
+		u += 2 * r * math.Cos(ang/3)
+	}
+
+	v := math.Sqrt(u*u + q)
+	uv := u + v
+	if u < 0 {
+		uv = q / (v - u)
+	}
+	w := (uv - q) / (2 * v)
+	return uv / (math.Sqrt(uv+w*w) + w)
+}
+
+// sumx returns sum of u and v with its rounding error.
+func sumx(u, v float64) (s, t float64) {
+	s = u + v
+	up := s - v
+	vpp := s - up
+	up -= u
+	vpp -= v
+	return s, -(up + vpp)
+}
+
+// angNormalize reduces angle to (-180, 180].
+func angNormalize(x float64) float64 {
+	y := math.Remainder(x, 360)
+	if y == -180 {
+		return 180
+	}
+	return y
+}
+
+// angDiff returns y - x reduced to (-180, 180] exactly, as sum of d and small error e.
+func angDiff(x, y float64) (d, e float64) {
+	d, t := sumx(angNormalize(-x), angNormalize(y))
+	d = angNormalize(d)
+	if d == 180 && t > 0 {
+		d = -180
+	}
+	return sumx(d, t)
+}
+
+// angRound rounds tiny angles to zero so that they are handled consistently near the equator.
+func angRound(x float64) float64 {
+	const z = 1.0 / 16
+	if x == 0 {
+		return 0
+	}
+	y := math.Abs(x)
+	if y < z {
+		y = z - (z - y)
+	}
+	return math.Copysign(y, x)
+}
+
+func latFix(x float64) float64 {
+	if math.Abs(x) > 90 {
+		return math.NaN()
+	}
+	return x
+}
+
+// sincosd returns sine and cosine of x in degrees, exact for multiples of 90.
+func sincosd(x float64) (sinx, cosx float64) {
+	r := math.Mod(x, 360)
+	q := math.Round(r / 90)
+	r -= 90 * q
+	s, c := math.Sincos(r * math.Pi / 180)
+	switch int(q) & 3 {
+	case 0:
+		sinx, cosx = s, c
+	case 1:
+		sinx, cosx = c, -s
+	case 2:
+		sinx, cosx = -s, -c
+	default:
+		sinx, cosx = -c, s
+	}
+	cosx += 0
+	if sinx == 0 {
+		sinx = math.Copysign(0, x)
+	}
+	return sinx, cosx
+}
+
+// atan2d returns atan2 in degrees, exact at multiples of 90.
+func atan2d(y, x float64) float64 {
+	q := 0
+	if math.Abs(y) > math.Abs(x) {
+		x, y = y, x
+		q = 2
+	}
+	if math.Signbit(x) {
+		x = -x
+		q++
+	}
+	ang := math.Atan2(y, x) * 180 / math.Pi
+	switch q {
+	case 1:
+		ang = math.Copysign(180, y) - ang
+	case 2:
+		ang = 90 - ang
+	case 3:
+		ang = -90 + ang
+	}
+	return ang
+}
+
+func norm2(x, y float64) (float64, float64) {
+	r := math.Hypot(x, y)
+	return x / r, y / r
+}
+
+func bearing(salp, calp float64) float64 {
+	return normalizeBearing(atan2d(salp, calp))
+}
+
+// normalizeBearing reduces angle in degrees to [0, 360).
+func normalizeBearing(b float64) float64 {
+	b = math.Mod(b, 360)
+	if b < 0 {
+		b += 360
+	}
+	return b + 0
+}
@@ -0,0 +1,123 @@
+package geodesic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shaxbee/go-spatialite/wkb"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	jfk = wkb.Point{X: -73.8, Y: 40.6}
+	lhr = wkb.Point{X: -0.5, Y: 51.6}
+)
+
+func TestInverse(t *testing.T) {
+	valid := []struct {
+		p1, p2                       wkb.Point
+		distance, bearing1, bearing2 float64
+	}{
+		// reference values from GeographicLib
+		{jfk, lhr, 5551759.400319, 51.198882845580, 107.821776735514},
+		// Flinders Peak to Buninyong, T. Vincenty, Survey Review 23 (1975)
+		{
+			wkb.Point{X: 144 + 25/60.0 + 29.52440/3600, Y: -(37 + 57/60.0 + 3.72030/3600)},
+			wkb.Point{X: 143 + 55/60.0 + 35.38390/3600, Y: -(37 + 39/60.0 + 10.15610/3600)},
+			54972.271, 306 + 52/60.0 + 5.37/3600, 307 + 10/60.0 + 25.07/3600,
+		},
+		{wkb.Point{X: 0, Y: 0}, wkb.Point{X: 0, Y: 90}, 10001965.729312, 0, 0},
+		{wkb.Point{X: 10, Y: 0}, wkb.Point{X: 20, Y: 0}, 1113194.907933, 90, 90},
+		{wkb.Point{X: 20, Y: 0}, wkb.Point{X: 10, Y: 0}, 1113194.907933, 270, 270},
+		{wkb.Point{X: 5, Y: 5}, wkb.Point{X: 5, Y: 5}, 0, 180, 180},
+	}
+
+	for _, e := range valid {
+		distance, bearing1, bearing2 := WGS84.Inverse(e.p1, e.p2)
+		assert.InDelta(t, e.distance, distance, 1e-3, "%v %v", e.p1, e.p2)
+		assert.InDelta(t, e.bearing1, bearing1, 1e-5, "%v %v", e.p1, e.p2)
+		assert.InDelta(t, e.bearing2, bearing2, 1e-5, "%v %v", e.p1, e.p2)
+	}
+
+	assert.Equal(t, WGS84.Distance(jfk, lhr), Distance(jfk, lhr))
+	assert.InDelta(t, 51.198882845580, WGS84.InitialBearing(jfk, lhr), 1e-9)
+	assert.InDelta(t, 107.821776735514, WGS84.FinalBearing(jfk, lhr), 1e-9)
+
+	// nearly antipodal points converge
+	assert.InDelta(t, 20003931.458625, WGS84.Distance(wkb.Point{X: 0, Y: 0}, wkb.Point{X: 180, Y: 0}), 1e-3)
+	assert.InDelta(t, 19936288.578965, WGS84.Distance(wkb.Point{X: 0, Y: 0}, wkb.Point{X: 179.5, Y: 0.5}), 1e-3)
+}
+
+func TestDirect(t *testing.T) {
+	p, bearing := WGS84.Direct(wkb.Point{X: -73.77888889, Y: 40.63972222}, 53.5, 5850e3)
+	assert.InDelta(t, 2.56106226, p.X, 1e-8)
+	assert.InDelta(t, 49.01466893, p.Y, 1e-8)
+	assert.InDelta(t, 111.629467, bearing, 1e-6)
+
+	// inverse of destination returns to starting point
+	cases := [][2]wkb.Point{
+		{jfk, lhr},
+		{wkb.Point{X: 170, Y: -30}, wkb.Point{X: -170, Y: -35}},
+		{wkb.Point{X: 0, Y: -89}, wkb.Point{X: 90, Y: 89}},
+		{wkb.Point{X: 10, Y: 20}, wkb.Point{X: -170, Y: -19.9}},
+		{wkb.Point{X: 1, Y: 2}, wkb.Point{X: 1.0000001, Y: 2.0000001}},
+	}
+	for _, c := range cases {
+		distance, bearing, _ := WGS84.Inverse(c[0], c[1])
+		actual := WGS84.Destination(c[0], bearing, distance)
+		assert.InDelta(t, 0, WGS84.Distance(actual, c[1]), 1e-6, "%v %v", c[0], c[1])
+	}
+}
+
+func TestEllipsoidLength(t *testing.T) {
+	ls := wkb.LineString{jfk, lhr, wkb.Point{X: 2.55, Y: 49.01}}
+	assert.Equal(t, WGS84.Distance(jfk, lhr)+WGS84.Distance(lhr, ls[2]), WGS84.Length(ls))
+	assert.Equal(t, 0.0, WGS84.Length(wkb.LineString{jfk}))
+	assert.Equal(t, 0.0, WGS84.Length(wkb.LineString{}))
+}
+
+func TestEllipsoidArea(t *testing.T) {
+	// closed form area of ellipsoid of revolution
+	a, e2 := 6378137.0, WGS84.e2
+	e := math.Sqrt(e2)
+	total := 2 * math.Pi * a * a * (1 + (1-e2)/e*math.Atanh(e))
+
+	octant := wkb.LinearRing{{X: 0, Y: 0}, {X: 90, Y: 0}, {X: 0, Y: 90}, {X: 0, Y: 0}}
+	assert.InDelta(t, total/8, WGS84.Area(wkb.Polygon{octant}), 1e-2)
+
+	// orientation does not matter, unclosed ring is closed implicitly
+	reversed := wkb.LinearRing{{X: 0, Y: 0}, {X: 0, Y: 90}, {X: 90, Y: 0}}
+	assert.InDelta(t, total/8, WGS84.Area(wkb.Polygon{reversed}), 1e-2)
+
+	// northern hemisphere bounded by equator, ring encircles pole
+	equator := wkb.LinearRing{}
+	for lon := -180.0; lon <= 180; lon += 10 {
+		equator = append(equator, wkb.Point{X: lon, Y: 0})
+	}
+	assert.InDelta(t, total/2, WGS84.Area(wkb.Polygon{equator}), 1e-1)
+
+	// ring crossing antimeridian
+	square := func(x, y float64) wkb.LinearRing {
+		return wkb.LinearRing{{X: x, Y: y}, {X: x + 2, Y: y}, {X: x + 2, Y: y + 1}, {X: x, Y: y + 1}, {X: x, Y: y}}
+	}
+	area := WGS84.Area(wkb.Polygon{square(0, 0)})
+	assert.InDelta(t, 24619443759.277, area, 1e-3)
+	assert.InDelta(t, area, WGS84.Area(wkb.Polygon{square(179, 0)}), 1e-3)
+
+	// oblique edge, checked against numerical integration along geodesic
+	triangle := wkb.LinearRing{{X: 0, Y: 0}, {X: 40, Y: 0}, {X: 40, Y: 50}, {X: 0, Y: 0}}
+	assert.InEpsilon(t, 13615498589246.5, WGS84.Area(wkb.Polygon{triangle}), 1e-10)
+
+	hole := wkb.LinearRing{{X: 10, Y: 10}, {X: 10, Y: 20}, {X: 20, Y: 20}, {X: 20, Y: 10}, {X: 10, Y: 10}}
+	assert.InDelta(t, total/8-WGS84.Area(wkb.Polygon{hole}), WGS84.Area(wkb.Polygon{octant, hole}), 1e-1)
+
+	assert.Equal(t, 0.0, WGS84.Area(wkb.Polygon{}))
+	assert.Equal(t, 0.0, WGS84.Area(wkb.Polygon{{{X: 0, Y: 0}, {X: 1, Y: 1}}}))
+}
+
+func TestNewEllipsoid(t *testing.T) {
+	sphere := NewEllipsoid(Earth.Radius, 0)
+	p1, p2 := wkb.Point{X: 0, Y: 0}, wkb.Point{X: 90, Y: 0}
+	assert.InDelta(t, Earth.Radius*math.Pi/2, sphere.Distance(p1, p2), 1e-6)
+	assert.InDelta(t, Earth.Distance(jfk, lhr), sphere.Distance(jfk, lhr), 1e-6)
+}
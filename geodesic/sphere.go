@@ -0,0 +1,112 @@
+package geodesic
+
+import (
+	"math"
+
+	"github.com/shaxbee/go-spatialite/wkb"
+)
+
+// Sphere is sphere with radius in meters, faster but less accurate model of Earth than Ellipsoid.
+type Sphere struct {
+	Radius float64
+}
+
+// Earth is sphere with mean radius of WGS84.
+var Earth = Sphere{6371008.8}
+
+// Haversine returns great circle distance between points on Earth.
+func Haversine(p1, p2 wkb.Point) float64 {
+	return Earth.Distance(p1, p2)
+}
+
+// Distance returns great circle distance between points using haversine formula.
+func (s Sphere) Distance(p1, p2 wkb.Point) float64 {
+	lat1, lat2 := radians(p1.Y), radians(p2.Y)
+	dlat, dlon := lat2-lat1, radians(p2.X-p1.X)
+
+	h := hav(dlat) + math.Cos(lat1)*math.Cos(lat2)*hav(dlon)
+	return 2 * s.Radius * math.Asin(math.Sqrt(math.Min(1, h)))
+}
+
+// InitialBearing returns bearing at p1 of great circle from p1 to p2.
+func (s Sphere) InitialBearing(p1, p2 wkb.Point) float64 {
+	lat1, lat2 := radians(p1.Y), radians(p2.Y)
+	sdlon, cdlon := math.Sincos(radians(p2.X - p1.X))
+
+	y := sdlon * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*cdlon
+	return normalizeBearing(math.Atan2(y, x) * 180 / math.Pi)
+}
+
+// FinalBearing returns bearing at p2 of great circle from p1 to p2.
+func (s Sphere) FinalBearing(p1, p2 wkb.Point) float64 {
+	return normalizeBearing(s.InitialBearing(p2, p1) + 180)
+}
+
+// Destination returns point at distance from p following great circle with initial bearing.
+func (s Sphere) Destination(p wkb.Point, bearing, distance float64) wkb.Point {
+	lat1, lon1 := radians(p.Y), radians(p.X)
+	sd, cd := math.Sincos(distance / s.Radius)
+	sb, cb := math.Sincos(radians(bearing))
+	slat1, clat1 := math.Sincos(lat1)
+
+	slat2 := math.Max(-1, math.Min(1, slat1*cd+clat1*sd*cb))
+	lon2 := lon1 + math.Atan2(sb*sd*clat1, cd-slat1*slat2)
+	return wkb.Point{X: angNormalize(lon2 * 180 / math.Pi), Y: math.Asin(slat2) * 180 / math.Pi}
+}
+
+// Length returns great circle length of line string.
+func (s Sphere) Length(ls wkb.LineString) float64 {
+	length := 0.0
+	for i := 1; i < len(ls); i++ {
+		length += s.Distance(ls[i-1], ls[i])
+	}
+	return length
+}
+
+// Area returns area of polygon with great circle edges, exterior ring less interior rings.
+// Orientation of rings does not matter, area of ring is the smaller one of two it divides surface into.
+func (s Sphere) Area(p wkb.Polygon) float64 {
+	area := 0.0
+	for i, r := range p {
+		if i == 0 {
+			area += s.ringArea(r)
+		} else {
+			area -= s.ringArea(r)
+		}
+	}
+	return area
+}
+
+func (s Sphere) ringArea(r wkb.LinearRing) float64 {
+	if len(r) < 3 {
+		return 0
+	}
+
+	sum := 0.0
+	crossings := 0
+	edge := func(p1, p2 wkb.Point) {
+		// signed spherical excess of area between edge and equator
+		dlon, _ := angDiff(p1.X, p2.X)
+		t1, t2 := math.Tan(radians(p1.Y)/2), math.Tan(radians(p2.Y)/2)
+		sum += 2 * math.Atan2(math.Tan(radians(dlon)/2)*(t1+t2), 1+t1*t2)
+		crossings += transit(p1.X, p2.X)
+	}
+	for i := 1; i < len(r); i++ {
+		edge(r[i-1], r[i])
+	}
+	if first, last := r[0], r[len(r)-1]; first != last {
+		edge(last, first)
+	}
+
+	return s.Radius * s.Radius * reduceArea(sum, 4*math.Pi, crossings)
+}
+
+func hav(x float64) float64 {
+	s := math.Sin(x / 2)
+	return s * s
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,67 @@
+package geodesic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shaxbee/go-spatialite/wkb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSphere(t *testing.T) {
+	unit := Sphere{1}
+	origin := wkb.Point{X: 0, Y: 0}
+
+	assert.InDelta(t, math.Pi/2, unit.Distance(origin, wkb.Point{X: 90, Y: 0}), 1e-15)
+	assert.InDelta(t, math.Pi/2, unit.Distance(origin, wkb.Point{X: 0, Y: -90}), 1e-15)
+	assert.InDelta(t, math.Pi, unit.Distance(origin, wkb.Point{X: 180, Y: 0}), 1e-15)
+	assert.Equal(t, 0.0, unit.Distance(jfk, jfk))
+
+	// haversine is within 0.5% of geodesic distance
+	assert.Equal(t, Earth.Distance(jfk, lhr), Haversine(jfk, lhr))
+	assert.InEpsilon(t, WGS84.Distance(jfk, lhr), Haversine(jfk, lhr), 5e-3)
+
+	assert.InDelta(t, 45, unit.InitialBearing(origin, wkb.Point{X: 90, Y: 45}), 1e-12)
+	assert.InDelta(t, 270, unit.InitialBearing(origin, wkb.Point{X: -10, Y: 0}), 1e-12)
+	assert.InDelta(t, 90, unit.FinalBearing(origin, wkb.Point{X: 90, Y: 45}), 1e-12)
+	assert.InDelta(t, 51.2, Earth.InitialBearing(jfk, lhr), 0.5)
+	assert.InDelta(t, 107.8, Earth.FinalBearing(jfk, lhr), 0.5)
+
+	p := unit.Destination(origin, 90, math.Pi/2)
+	assert.InDelta(t, 90, p.X, 1e-12)
+	assert.InDelta(t, 0, p.Y, 1e-12)
+	p = unit.Destination(wkb.Point{X: 170, Y: 0}, 90, math.Pi/9)
+	assert.InDelta(t, -170, p.X, 1e-12)
+
+	distance, bearing := Earth.Distance(jfk, lhr), Earth.InitialBearing(jfk, lhr)
+	p = Earth.Destination(jfk, bearing, distance)
+	assert.InDelta(t, lhr.X, p.X, 1e-9)
+	assert.InDelta(t, lhr.Y, p.Y, 1e-9)
+
+	ls := wkb.LineString{origin, {X: 90, Y: 0}, {X: 90, Y: 90}}
+	assert.InDelta(t, math.Pi, unit.Length(ls), 1e-15)
+}
+
+func TestSphereArea(t *testing.T) {
+	unit := Sphere{1}
+
+	octant := wkb.LinearRing{{X: 0, Y: 0}, {X: 90, Y: 0}, {X: 0, Y: 90}, {X: 0, Y: 0}}
+	assert.InDelta(t, math.Pi/2, unit.Area(wkb.Polygon{octant}), 1e-15)
+
+	reversed := wkb.LinearRing{{X: 0, Y: 0}, {X: 0, Y: 90}, {X: 90, Y: 0}, {X: 0, Y: 0}}
+	assert.InDelta(t, math.Pi/2, unit.Area(wkb.Polygon{reversed}), 1e-15)
+
+	// ring encircling pole
+	hemisphere := wkb.LinearRing{{X: -180, Y: 0}, {X: -90, Y: 0}, {X: 0, Y: 0}, {X: 90, Y: 0}, {X: 180, Y: 0}}
+	assert.InDelta(t, 2*math.Pi, unit.Area(wkb.Polygon{hemisphere}), 1e-14)
+
+	square := func(x, y float64) wkb.LinearRing {
+		return wkb.LinearRing{{X: x, Y: y}, {X: x + 2, Y: y}, {X: x + 2, Y: y + 1}, {X: x, Y: y + 1}, {X: x, Y: y}}
+	}
+	area := Earth.Area(wkb.Polygon{square(0, 0)})
+	assert.InEpsilon(t, WGS84.Area(wkb.Polygon{square(0, 0)}), area, 5e-3)
+	assert.InDelta(t, area, Earth.Area(wkb.Polygon{square(179, 0)}), 1e-3)
+	assert.InDelta(t, area-Earth.Area(wkb.Polygon{square(0.5, 0.25)}), Earth.Area(wkb.Polygon{square(0, 0), square(0.5, 0.25)}), 1e-3)
+
+	assert.Equal(t, 0.0, unit.Area(wkb.Polygon{}))
+}
@@ -24,13 +24,24 @@ var ErrSpatialiteNotFound = errors.New("shaxbee/go-spatialite: spatialite extens
 
 func init() {
 	sql.Register("spatialite", &sqlite3.SQLiteDriver{
+		ConnectHook: loadExtension,
+	})
+	sql.Register("spatialite_gpkg", &sqlite3.SQLiteDriver{
 		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
-			for _, v := range LibNames {
-				if err := conn.LoadExtension(v.lib, v.proc); err == nil {
-					return nil
-				}
+			if err := loadExtension(conn); err != nil {
+				return err
 			}
-			return ErrSpatialiteNotFound
+			_, err := conn.Exec("SELECT EnableGpkgMode()", nil)
+			return err
 		},
 	})
 }
+
+func loadExtension(conn *sqlite3.SQLiteConn) error {
+	for _, v := range LibNames {
+		if err := conn.LoadExtension(v.lib, v.proc); err == nil {
+			return nil
+		}
+	}
+	return ErrSpatialiteNotFound
+}
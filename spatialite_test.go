@@ -2,6 +2,7 @@ package spatialite
 
 import (
 	"database/sql"
+	"path/filepath"
 	"testing"
 
 	"github.com/shaxbee/go-spatialite/wkb"
@@ -30,6 +31,157 @@ func TestPoint(t *testing.T) {
 	}
 }
 
+func TestNull(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	_, err := db.Exec("CREATE TABLE poi(title TEXT)")
+	require.NoError(t, err)
+
+	_, err = db.Exec("SELECT AddGeometryColumn('poi', 'loc', 4326, 'POINT')")
+	require.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO poi(title, loc) VALUES (?, ST_PointFromWKB(?, 4326))", "foo", wkb.Null[wkb.Point]{})
+	assert.NoError(t, err)
+
+	p := wkb.Null[wkb.Point]{Geometry: wkb.Point{X: 10, Y: 10}, Valid: true}
+	r := db.QueryRow("SELECT ST_AsBinary(loc) AS loc FROM poi WHERE title=?", "foo")
+	if err := r.Scan(&p); assert.NoError(t, err) {
+		assert.False(t, p.Valid)
+	}
+}
+
+func TestGeom(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	_, err := db.Exec("CREATE TABLE layer(id INTEGER)")
+	require.NoError(t, err)
+
+	_, err = db.Exec("SELECT AddGeometryColumn('layer', 'shape', 4326, 'GEOMETRY')")
+	require.NoError(t, err)
+
+	geoms := []wkb.Geometry{
+		wkb.Point{X: 10, Y: 10},
+		wkb.Polygon{wkb.LinearRing{{X: 30, Y: 10}, {X: 40, Y: 40}, {X: 20, Y: 40}, {X: 30, Y: 10}}},
+	}
+	for i, g := range geoms {
+		_, err = db.Exec("INSERT INTO layer(id, shape) VALUES (?, GeomFromWKB(?, 4326))", i, wkb.Geom{Geometry: g})
+		assert.NoError(t, err)
+	}
+
+	rows, err := db.Query("SELECT ST_AsBinary(shape) FROM layer ORDER BY id")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	actual := []wkb.Geometry{}
+	for rows.Next() {
+		g := wkb.Geom{}
+		if assert.NoError(t, rows.Scan(&g)) {
+			actual = append(actual, g.Geometry)
+		}
+	}
+	assert.NoError(t, rows.Err())
+	assert.Equal(t, geoms, actual)
+}
+
+func TestSpatialiteBlob(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	_, err := db.Exec("CREATE TABLE zone(title TEXT)")
+	require.NoError(t, err)
+
+	_, err = db.Exec("SELECT AddGeometryColumn('zone', 'area', 4326, 'POLYGON')")
+	require.NoError(t, err)
+
+	p1 := wkb.Spatialite{SRID: 4326, Geometry: wkb.Polygon{
+		wkb.LinearRing{{X: 30, Y: 10}, {X: 40, Y: 40}, {X: 20, Y: 40}, {X: 10, Y: 20}, {X: 30, Y: 10}},
+	}}
+	_, err = db.Exec("INSERT INTO zone(title, area) VALUES (?, ?)", "foo", p1)
+	assert.NoError(t, err)
+
+	var valid bool
+	r := db.QueryRow("SELECT ST_IsValid(area) FROM zone WHERE title=?", "foo")
+	if err := r.Scan(&valid); assert.NoError(t, err) {
+		assert.True(t, valid)
+	}
+
+	p2 := wkb.Spatialite{}
+	r = db.QueryRow("SELECT area FROM zone WHERE title=?", "foo")
+	if err := r.Scan(&p2); assert.NoError(t, err) {
+		assert.Equal(t, p1, p2)
+	}
+}
+
+func TestGeoPackage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.gpkg")
+
+	db, err := sql.Open("spatialite", path)
+	require.NoError(t, err)
+
+	_, err = db.Exec("SELECT gpkgCreateBaseTables()")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	db, err = sql.Open("spatialite_gpkg", path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var mode bool
+	r := db.QueryRow("SELECT GetGpkgMode()")
+	if err := r.Scan(&mode); assert.NoError(t, err) {
+		assert.True(t, mode)
+	}
+
+	gp := wkb.GeoPackage{}
+	r = db.QueryRow("SELECT AsGPB(ST_GeomFromText('LINESTRING(10 10, 40 40)', 4326))")
+	if err := r.Scan(&gp); assert.NoError(t, err) {
+		assert.Equal(t, wkb.GeoPackage{SRID: 4326, Geometry: wkb.LineString{{X: 10, Y: 10}, {X: 40, Y: 40}}}, gp)
+	}
+}
+
+func TestRelate(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	geoms := []wkb.Geometry{
+		wkb.Polygon{wkb.LinearRing{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 0, Y: 10}, {X: 0, Y: 0}}},
+		wkb.Polygon{wkb.LinearRing{{X: 5, Y: 5}, {X: 15, Y: 5}, {X: 15, Y: 15}, {X: 5, Y: 15}, {X: 5, Y: 5}}},
+		wkb.LineString{{X: -5, Y: 5}, {X: 5, Y: 5}},
+		wkb.LineString{{X: 0, Y: 0}, {X: 10, Y: 0}},
+		wkb.Point{X: 10, Y: 5},
+		wkb.MultiPoint{{X: 1, Y: 1}, {X: 20, Y: 20}},
+	}
+
+	var pairs [][2]wkb.Geometry
+	for _, a := range geoms {
+		for _, b := range geoms {
+			pairs = append(pairs, [2]wkb.Geometry{a, b})
+		}
+	}
+
+	// polygons touching at single point
+	touching := wkb.MultiPolygon{
+		{{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}, {X: 0, Y: 0}}},
+		{{{X: 1, Y: 1}, {X: 2, Y: 1}, {X: 2, Y: 2}, {X: 1, Y: 2}, {X: 1, Y: 1}}},
+	}
+	pairs = append(pairs,
+		[2]wkb.Geometry{touching, wkb.Point{X: 1, Y: 1}},
+		[2]wkb.Geometry{touching, wkb.LineString{{X: 0.5, Y: 0.5}, {X: 1.5, Y: 1.5}}},
+	)
+
+	// matrix computed in Go matches ST_Relate
+	for _, p := range pairs {
+		a, b := p[0], p[1]
+		var expected string
+		r := db.QueryRow("SELECT ST_Relate(GeomFromWKB(?), GeomFromWKB(?))", wkb.Geom{Geometry: a}, wkb.Geom{Geometry: b})
+		if err := r.Scan(&expected); assert.NoError(t, err) {
+			assert.Equal(t, expected, wkb.Relate(a, b).String(), "Relate(%v, %v)", a, b)
+		}
+	}
+}
+
 func makeDB(t *testing.T) *sql.DB {
 	db, err := sql.Open("spatialite", "file:dummy.db?mode=memory&cache=shared")
 	require.NoError(t, err)
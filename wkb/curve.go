@@ -0,0 +1,395 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// Scan decodes geometry into cs, reusing its backing arrays as UnmarshalTo does.
+func (cs *CircularString) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cs.UnmarshalBinary(b)
+}
+
+func (cs CircularString) Value() (driver.Value, error) {
+	return cs.MarshalBinary()
+}
+
+func (cs CircularString) MarshalBinary() ([]byte, error) {
+	return cs.AppendWKB(make([]byte, 0, cs.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into cs, reusing its backing arrays as UnmarshalTo does.
+func (cs *CircularString) UnmarshalBinary(b []byte) error {
+	return cs.unmarshal(newDecoder(b), b)
+}
+
+func (cs *CircularString) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.circularString(b, reuse(d, b, *cs))
+	if err != nil {
+		return err
+	}
+
+	*cs = tmp
+	return nil
+}
+
+func ReadCircularString(b []byte) ([]byte, CircularString, error) {
+	return newDecoder(b).circularString(b, nil)
+}
+
+func (d *decoder) circularString(b []byte, dst CircularString) ([]byte, CircularString, error) {
+	b, dec, err := d.header(b, GeomCircularString)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, pts, err := d.points(b, dec, Points(dst))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !validArcs(len(pts)) {
+		return nil, nil, d.fail(b, ErrInvalidStorage)
+	}
+	return rest, CircularString(pts), nil
+}
+
+func (cs CircularString) IsEmpty() bool {
+	return len(cs) == 0
+}
+
+func (cs CircularString) Kind() Kind {
+	return GeomCircularString
+}
+
+func (cs CircularString) Dimension() int {
+	return 1
+}
+
+// Envelope returns bounding box of arcs, which may extend past their control points.
+func (cs CircularString) Envelope() Envelope {
+	return arcEnvelope(cs)
+}
+
+func (cs CircularString) NumPoints() int {
+	return len(cs)
+}
+
+func (cs CircularString) Equal(other Geometry) bool {
+	o, ok := other.(CircularString)
+	return ok && equalPoints(cs, o)
+}
+
+// Centroid returns center of mass of linearized arcs, empty point when it is empty.
+func (cs CircularString) Centroid() Point {
+	c := centroid{}
+	cs.addCentroid(&c)
+	return c.result()
+}
+
+func (cs CircularString) addCentroid(c *centroid) {
+	cs.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cs CircularString) addShape(s *shape) {
+	cs.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cs CircularString) ByteSize() int {
+	return HeaderSize + Points(cs).byteSize()
+}
+
+func (cs CircularString) Write(buf *bytes.Buffer) {
+	buf.Write(cs.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cs CircularString) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cs.encode(e)
+	return e.release()
+}
+
+func (cs CircularString) encode(e *encoder) {
+	e.header(GeomCircularString)
+	Points(cs).encode(e)
+}
+
+func (cc *CompoundCurve) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cc.UnmarshalBinary(b)
+}
+
+func (cc CompoundCurve) Value() (driver.Value, error) {
+	return cc.MarshalBinary()
+}
+
+func (cc CompoundCurve) MarshalBinary() ([]byte, error) {
+	return cc.AppendWKB(make([]byte, 0, cc.ByteSize())), nil
+}
+
+func (cc *CompoundCurve) UnmarshalBinary(b []byte) error {
+	return cc.unmarshal(newDecoder(b), b)
+}
+
+func (cc *CompoundCurve) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.compoundCurve(b)
+	if err != nil {
+		return err
+	}
+
+	*cc = tmp
+	return nil
+}
+
+func ReadCompoundCurve(b []byte) ([]byte, CompoundCurve, error) {
+	return newDecoder(b).compoundCurve(b)
+}
+
+// compoundCurve reads curve made of line string and circular string segments.
+func (d *decoder) compoundCurve(b []byte) ([]byte, CompoundCurve, error) {
+	b, dec, err := d.header(b, GeomCompoundCurve)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomLineString, GeomCircularString)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (cc CompoundCurve) IsEmpty() bool {
+	return isEmpty(cc)
+}
+
+func (cc CompoundCurve) Kind() Kind {
+	return GeomCompoundCurve
+}
+
+func (cc CompoundCurve) Dimension() int {
+	return 1
+}
+
+func (cc CompoundCurve) Envelope() Envelope {
+	return envelopeAll(cc)
+}
+
+func (cc CompoundCurve) NumPoints() int {
+	return numPointsAll(cc)
+}
+
+func (cc CompoundCurve) Equal(other Geometry) bool {
+	o, ok := other.(CompoundCurve)
+	return ok && equalAll(cc, o)
+}
+
+// Centroid returns center of mass of linearized curve, empty point when it is empty.
+func (cc CompoundCurve) Centroid() Point {
+	c := centroid{}
+	cc.addCentroid(&c)
+	return c.result()
+}
+
+func (cc CompoundCurve) addCentroid(c *centroid) {
+	cc.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cc CompoundCurve) addShape(s *shape) {
+	cc.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cc CompoundCurve) ByteSize() int {
+	return membersSize(cc)
+}
+
+func (cc CompoundCurve) Write(buf *bytes.Buffer) {
+	buf.Write(cc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cc CompoundCurve) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cc.encode(e)
+	return e.release()
+}
+
+func (cc CompoundCurve) encode(e *encoder) {
+	e.members(GeomCompoundCurve, cc)
+}
+
+func (mc *MultiCurve) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mc.UnmarshalBinary(b)
+}
+
+func (mc MultiCurve) Value() (driver.Value, error) {
+	return mc.MarshalBinary()
+}
+
+func (mc MultiCurve) MarshalBinary() ([]byte, error) {
+	return mc.AppendWKB(make([]byte, 0, mc.ByteSize())), nil
+}
+
+func (mc *MultiCurve) UnmarshalBinary(b []byte) error {
+	return mc.unmarshal(newDecoder(b), b)
+}
+
+func (mc *MultiCurve) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiCurve(b)
+	if err != nil {
+		return err
+	}
+
+	*mc = tmp
+	return nil
+}
+
+func ReadMultiCurve(b []byte) ([]byte, MultiCurve, error) {
+	return newDecoder(b).multiCurve(b)
+}
+
+func (d *decoder) multiCurve(b []byte) ([]byte, MultiCurve, error) {
+	b, dec, err := d.header(b, GeomMultiCurve)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomLineString, GeomCircularString, GeomCompoundCurve)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (mc MultiCurve) IsEmpty() bool {
+	return isEmpty(mc)
+}
+
+func (mc MultiCurve) Kind() Kind {
+	return GeomMultiCurve
+}
+
+func (mc MultiCurve) Dimension() int {
+	return 1
+}
+
+func (mc MultiCurve) Envelope() Envelope {
+	return envelopeAll(mc)
+}
+
+func (mc MultiCurve) NumPoints() int {
+	return numPointsAll(mc)
+}
+
+func (mc MultiCurve) Equal(other Geometry) bool {
+	o, ok := other.(MultiCurve)
+	return ok && equalAll(mc, o)
+}
+
+// Centroid returns center of mass of linearized curves, empty point when it is empty.
+func (mc MultiCurve) Centroid() Point {
+	c := centroid{}
+	mc.addCentroid(&c)
+	return c.result()
+}
+
+func (mc MultiCurve) addCentroid(c *centroid) {
+	mc.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (mc MultiCurve) addShape(s *shape) {
+	mc.Linearize(DefaultSegments).addShape(s)
+}
+
+func (mc MultiCurve) ByteSize() int {
+	return membersSize(mc)
+}
+
+func (mc MultiCurve) Write(buf *bytes.Buffer) {
+	buf.Write(mc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mc MultiCurve) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mc.encode(e)
+	return e.release()
+}
+
+func (mc MultiCurve) encode(e *encoder) {
+	e.members(GeomMultiCurve, mc)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+// Points not forming whole arc, left over when number of points is invalid, are joined with straight segments.
+func (cs CircularString) Linearize(segments int) LineString {
+	if len(cs) == 0 {
+		return LineString{}
+	}
+
+	ls := LineString{cs[0]}
+	i := 2
+	for ; i < len(cs); i += 2 {
+		p0, p1, p2 := cs[i-2], cs[i-1], cs[i]
+		for _, a := range arc(p0.X, p0.Y, p1.X, p1.Y, p2.X, p2.Y, segments) {
+			ls = append(ls, Point{a.x, a.y})
+		}
+	}
+	return append(ls, cs[i-1:]...)
+}
+
+func (cs CircularString) linearize(segments int) Geometry {
+	return cs.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+// Points shared by consecutive members are not repeated.
+func (cc CompoundCurve) Linearize(segments int) LineString {
+	ls := LineString{}
+	for _, g := range cc {
+		part := linearizeCurve(g, segments)
+		if len(ls) > 0 && len(part) > 0 && equalPoint(ls[len(ls)-1], part[0]) {
+			part = part[1:]
+		}
+		ls = append(ls, part...)
+	}
+	return ls
+}
+
+func (cc CompoundCurve) linearize(segments int) Geometry {
+	return cc.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (mc MultiCurve) Linearize(segments int) MultiLineString {
+	mls := make(MultiLineString, len(mc))
+	for i, g := range mc {
+		mls[i] = linearizeCurve(g, segments)
+	}
+	return mls
+}
+
+func (mc MultiCurve) linearize(segments int) Geometry {
+	return mc.Linearize(segments)
+}
+
+func linearizeCurve(g Geometry, segments int) LineString {
+	switch c := g.(type) {
+	case LineString:
+		return c
+	case CircularString:
+		return c.Linearize(segments)
+	case CompoundCurve:
+		return c.Linearize(segments)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,395 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// Scan decodes geometry into cs, reusing its backing arrays as UnmarshalTo does.
+func (cs *CircularStringM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cs.UnmarshalBinary(b)
+}
+
+func (cs CircularStringM) Value() (driver.Value, error) {
+	return cs.MarshalBinary()
+}
+
+func (cs CircularStringM) MarshalBinary() ([]byte, error) {
+	return cs.AppendWKB(make([]byte, 0, cs.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into cs, reusing its backing arrays as UnmarshalTo does.
+func (cs *CircularStringM) UnmarshalBinary(b []byte) error {
+	return cs.unmarshal(newDecoder(b), b)
+}
+
+func (cs *CircularStringM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.circularStringM(b, reuse(d, b, *cs))
+	if err != nil {
+		return err
+	}
+
+	*cs = tmp
+	return nil
+}
+
+func ReadCircularStringM(b []byte) ([]byte, CircularStringM, error) {
+	return newDecoder(b).circularStringM(b, nil)
+}
+
+func (d *decoder) circularStringM(b []byte, dst CircularStringM) ([]byte, CircularStringM, error) {
+	b, dec, err := d.header(b, GeomCircularStringM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, pts, err := d.pointsM(b, dec, PointsM(dst))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !validArcs(len(pts)) {
+		return nil, nil, d.fail(b, ErrInvalidStorage)
+	}
+	return rest, CircularStringM(pts), nil
+}
+
+func (cs CircularStringM) IsEmpty() bool {
+	return len(cs) == 0
+}
+
+func (cs CircularStringM) Kind() Kind {
+	return GeomCircularStringM
+}
+
+func (cs CircularStringM) Dimension() int {
+	return 1
+}
+
+// Envelope returns bounding box of arcs, which may extend past their control points.
+func (cs CircularStringM) Envelope() Envelope {
+	return arcEnvelope(cs)
+}
+
+func (cs CircularStringM) NumPoints() int {
+	return len(cs)
+}
+
+func (cs CircularStringM) Equal(other Geometry) bool {
+	o, ok := other.(CircularStringM)
+	return ok && equalPoints(cs, o)
+}
+
+// Centroid returns center of mass of linearized arcs, empty point when it is empty.
+func (cs CircularStringM) Centroid() Point {
+	c := centroid{}
+	cs.addCentroid(&c)
+	return c.result()
+}
+
+func (cs CircularStringM) addCentroid(c *centroid) {
+	cs.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cs CircularStringM) addShape(s *shape) {
+	cs.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cs CircularStringM) ByteSize() int {
+	return HeaderSize + PointsM(cs).byteSize()
+}
+
+func (cs CircularStringM) Write(buf *bytes.Buffer) {
+	buf.Write(cs.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cs CircularStringM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cs.encode(e)
+	return e.release()
+}
+
+func (cs CircularStringM) encode(e *encoder) {
+	e.header(GeomCircularStringM)
+	PointsM(cs).encode(e)
+}
+
+func (cc *CompoundCurveM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cc.UnmarshalBinary(b)
+}
+
+func (cc CompoundCurveM) Value() (driver.Value, error) {
+	return cc.MarshalBinary()
+}
+
+func (cc CompoundCurveM) MarshalBinary() ([]byte, error) {
+	return cc.AppendWKB(make([]byte, 0, cc.ByteSize())), nil
+}
+
+func (cc *CompoundCurveM) UnmarshalBinary(b []byte) error {
+	return cc.unmarshal(newDecoder(b), b)
+}
+
+func (cc *CompoundCurveM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.compoundCurveM(b)
+	if err != nil {
+		return err
+	}
+
+	*cc = tmp
+	return nil
+}
+
+func ReadCompoundCurveM(b []byte) ([]byte, CompoundCurveM, error) {
+	return newDecoder(b).compoundCurveM(b)
+}
+
+// compoundCurveM reads curve made of line string and circular string segments.
+func (d *decoder) compoundCurveM(b []byte) ([]byte, CompoundCurveM, error) {
+	b, dec, err := d.header(b, GeomCompoundCurveM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomLineStringM, GeomCircularStringM)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (cc CompoundCurveM) IsEmpty() bool {
+	return isEmpty(cc)
+}
+
+func (cc CompoundCurveM) Kind() Kind {
+	return GeomCompoundCurveM
+}
+
+func (cc CompoundCurveM) Dimension() int {
+	return 1
+}
+
+func (cc CompoundCurveM) Envelope() Envelope {
+	return envelopeAll(cc)
+}
+
+func (cc CompoundCurveM) NumPoints() int {
+	return numPointsAll(cc)
+}
+
+func (cc CompoundCurveM) Equal(other Geometry) bool {
+	o, ok := other.(CompoundCurveM)
+	return ok && equalAll(cc, o)
+}
+
+// Centroid returns center of mass of linearized curve, empty point when it is empty.
+func (cc CompoundCurveM) Centroid() Point {
+	c := centroid{}
+	cc.addCentroid(&c)
+	return c.result()
+}
+
+func (cc CompoundCurveM) addCentroid(c *centroid) {
+	cc.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cc CompoundCurveM) addShape(s *shape) {
+	cc.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cc CompoundCurveM) ByteSize() int {
+	return membersSize(cc)
+}
+
+func (cc CompoundCurveM) Write(buf *bytes.Buffer) {
+	buf.Write(cc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cc CompoundCurveM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cc.encode(e)
+	return e.release()
+}
+
+func (cc CompoundCurveM) encode(e *encoder) {
+	e.members(GeomCompoundCurveM, cc)
+}
+
+func (mc *MultiCurveM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mc.UnmarshalBinary(b)
+}
+
+func (mc MultiCurveM) Value() (driver.Value, error) {
+	return mc.MarshalBinary()
+}
+
+func (mc MultiCurveM) MarshalBinary() ([]byte, error) {
+	return mc.AppendWKB(make([]byte, 0, mc.ByteSize())), nil
+}
+
+func (mc *MultiCurveM) UnmarshalBinary(b []byte) error {
+	return mc.unmarshal(newDecoder(b), b)
+}
+
+func (mc *MultiCurveM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiCurveM(b)
+	if err != nil {
+		return err
+	}
+
+	*mc = tmp
+	return nil
+}
+
+func ReadMultiCurveM(b []byte) ([]byte, MultiCurveM, error) {
+	return newDecoder(b).multiCurveM(b)
+}
+
+func (d *decoder) multiCurveM(b []byte) ([]byte, MultiCurveM, error) {
+	b, dec, err := d.header(b, GeomMultiCurveM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomLineStringM, GeomCircularStringM, GeomCompoundCurveM)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (mc MultiCurveM) IsEmpty() bool {
+	return isEmpty(mc)
+}
+
+func (mc MultiCurveM) Kind() Kind {
+	return GeomMultiCurveM
+}
+
+func (mc MultiCurveM) Dimension() int {
+	return 1
+}
+
+func (mc MultiCurveM) Envelope() Envelope {
+	return envelopeAll(mc)
+}
+
+func (mc MultiCurveM) NumPoints() int {
+	return numPointsAll(mc)
+}
+
+func (mc MultiCurveM) Equal(other Geometry) bool {
+	o, ok := other.(MultiCurveM)
+	return ok && equalAll(mc, o)
+}
+
+// Centroid returns center of mass of linearized curves, empty point when it is empty.
+func (mc MultiCurveM) Centroid() Point {
+	c := centroid{}
+	mc.addCentroid(&c)
+	return c.result()
+}
+
+func (mc MultiCurveM) addCentroid(c *centroid) {
+	mc.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (mc MultiCurveM) addShape(s *shape) {
+	mc.Linearize(DefaultSegments).addShape(s)
+}
+
+func (mc MultiCurveM) ByteSize() int {
+	return membersSize(mc)
+}
+
+func (mc MultiCurveM) Write(buf *bytes.Buffer) {
+	buf.Write(mc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mc MultiCurveM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mc.encode(e)
+	return e.release()
+}
+
+func (mc MultiCurveM) encode(e *encoder) {
+	e.members(GeomMultiCurveM, mc)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+// Points not forming whole arc, left over when number of points is invalid, are joined with straight segments.
+func (cs CircularStringM) Linearize(segments int) LineStringM {
+	if len(cs) == 0 {
+		return LineStringM{}
+	}
+
+	ls := LineStringM{cs[0]}
+	i := 2
+	for ; i < len(cs); i += 2 {
+		p0, p1, p2 := cs[i-2], cs[i-1], cs[i]
+		for _, a := range arc(p0.X, p0.Y, p1.X, p1.Y, p2.X, p2.Y, segments) {
+			ls = append(ls, PointM{a.x, a.y, interpolate(a.t, p0.M, p1.M, p2.M)})
+		}
+	}
+	return append(ls, cs[i-1:]...)
+}
+
+func (cs CircularStringM) linearize(segments int) Geometry {
+	return cs.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+// Points shared by consecutive members are not repeated.
+func (cc CompoundCurveM) Linearize(segments int) LineStringM {
+	ls := LineStringM{}
+	for _, g := range cc {
+		part := linearizeCurveM(g, segments)
+		if len(ls) > 0 && len(part) > 0 && equalPoint(ls[len(ls)-1], part[0]) {
+			part = part[1:]
+		}
+		ls = append(ls, part...)
+	}
+	return ls
+}
+
+func (cc CompoundCurveM) linearize(segments int) Geometry {
+	return cc.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (mc MultiCurveM) Linearize(segments int) MultiLineStringM {
+	mls := make(MultiLineStringM, len(mc))
+	for i, g := range mc {
+		mls[i] = linearizeCurveM(g, segments)
+	}
+	return mls
+}
+
+func (mc MultiCurveM) linearize(segments int) Geometry {
+	return mc.Linearize(segments)
+}
+
+func linearizeCurveM(g Geometry, segments int) LineStringM {
+	switch c := g.(type) {
+	case LineStringM:
+		return c
+	case CircularStringM:
+		return c.Linearize(segments)
+	case CompoundCurveM:
+		return c.Linearize(segments)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,177 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawCircularStringM = []byte{
+		0x01, 0xd8, 0x07, 0x00, 0x00, // header
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	}
+	rawCompoundCurveM = []byte{
+		0x01, 0xd9, 0x07, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numcurves - 2
+		0x01, 0xd8, 0x07, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x01, 0xd2, 0x07, 0x00, 0x00, // linestring
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+	}
+	rawMultiCurveM = []byte{
+		0x01, 0xdb, 0x07, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numcurves - 2
+		0x01, 0xd2, 0x07, 0x00, 0x00, // linestring
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+		0x01, 0xd8, 0x07, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	}
+)
+
+func TestCircularStringM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawLineStringM,
+		},
+		{
+			// no points
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0xd8, 0x07, 0x00, 0x00, // header
+				0x03, 0x00, 0x00, 0x00, // numpoints - 3
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CircularStringM{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cs := CircularStringM{}
+	if err := cs.Scan(rawCircularStringM); assert.NoError(t, err) {
+		assert.Equal(t, CircularStringM{{0, 0, 5}, {1, 1, 6}, {2, 0, 7}}, cs)
+	}
+
+	if raw, err := cs.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCircularStringM, raw)
+		assert.Len(t, raw, cs.ByteSize())
+	}
+}
+
+func TestCompoundCurveM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawMultiCurveM,
+		},
+		{
+			// invalid member type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0xd9, 0x07, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numcurves - 1
+				0x01, 0xd1, 0x07, 0x00, 0x00, // point
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+			},
+		},
+		{
+			// no members
+			ErrInvalidStorage,
+			rawCompoundCurveM[:HeaderSize+CountSize],
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CompoundCurveM{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cc := CompoundCurveM{}
+	if err := cc.Scan(rawCompoundCurveM); assert.NoError(t, err) {
+		assert.Equal(t, CompoundCurveM{
+			CircularStringM{{0, 0, 5}, {1, 1, 6}, {2, 0, 7}},
+			LineStringM{{2, 0, 7}, {4, 0, 8}},
+		}, cc)
+	}
+
+	if raw, err := cc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCompoundCurveM, raw)
+		assert.Len(t, raw, cc.ByteSize())
+	}
+}
+
+func TestMultiCurveM(t *testing.T) {
+	if err := (&MultiCurveM{}).Scan(rawCompoundCurveM); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	mc := MultiCurveM{}
+	if err := mc.Scan(rawMultiCurveM); assert.NoError(t, err) {
+		assert.Equal(t, MultiCurveM{
+			LineStringM{{2, 0, 7}, {4, 0, 8}},
+			CircularStringM{{0, 0, 5}, {1, 1, 6}, {2, 0, 7}},
+		}, mc)
+	}
+
+	if raw, err := mc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiCurveM, raw)
+		assert.Len(t, raw, mc.ByteSize())
+	}
+}
@@ -0,0 +1,192 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawCircularString = []byte{
+		0x01, 0x08, 0x00, 0x00, 0x00, // header
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	rawCompoundCurve = []byte{
+		0x01, 0x09, 0x00, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numcurves - 2
+		0x01, 0x08, 0x00, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x01, 0x02, 0x00, 0x00, 0x00, // linestring
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	rawMultiCurve = []byte{
+		0x01, 0x0b, 0x00, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numcurves - 2
+		0x01, 0x02, 0x00, 0x00, 0x00, // linestring
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x01, 0x08, 0x00, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+)
+
+func TestCircularString(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawLineString,
+		},
+		{
+			// no points
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0x08, 0x00, 0x00, 0x00, // header
+				0x03, 0x00, 0x00, 0x00, // numpoints - 3
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CircularString{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	// arcs need odd number of at least 3 points
+	for _, pts := range []CircularString{{{0, 0}}, {{0, 0}, {1, 1}}, {{0, 0}, {1, 1}, {2, 0}, {3, 1}}} {
+		_, _, err := ReadCircularString(Marshal(pts))
+		var derr *DecodeError
+		if assert.ErrorAs(t, err, &derr) {
+			assert.ErrorIs(t, err, ErrInvalidStorage)
+			assert.Equal(t, HeaderSize, derr.Offset)
+		}
+		_, err = New(Marshal(MultiCurve{pts}))
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+	}
+	if _, empty, err := ReadCircularString(Marshal(CircularString{})); assert.NoError(t, err) {
+		assert.True(t, empty.IsEmpty())
+	}
+
+	cs := CircularString{}
+	if err := cs.Scan(rawCircularString); assert.NoError(t, err) {
+		assert.Equal(t, CircularString{{0, 0}, {1, 1}, {2, 0}}, cs)
+	}
+
+	if raw, err := cs.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCircularString, raw)
+		assert.Len(t, raw, cs.ByteSize())
+	}
+
+	// arcs bulge past their control points
+	assert.InDeltaSlice(t,
+		[]float64{-1, 0, 1, 1},
+		envelopeSlice(CircularString{{-1, 0}, {0, 1}, {1, 0}}.Envelope()), 1e-12)
+	assert.InDeltaSlice(t,
+		[]float64{-1, -1, 1, 1},
+		envelopeSlice(CircularString{{0, -1}, {0, 1}, {0, -1}}.Envelope()), 1e-12)
+	assert.InDeltaSlice(t,
+		[]float64{0, -1, 2, 1},
+		envelopeSlice(CircularString{{1, 1}, {0, 0}, {1, -1}, {2, 0}, {1, 1}}.Envelope()), 1e-12)
+}
+
+func envelopeSlice(e Envelope) []float64 {
+	return []float64{e.MinX, e.MinY, e.MaxX, e.MaxY}
+}
+
+func TestCompoundCurve(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawMultiCurve,
+		},
+		{
+			// invalid member type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0x09, 0x00, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numcurves - 1
+				0x01, 0x01, 0x00, 0x00, 0x00, // point
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+		{
+			// no members
+			ErrInvalidStorage,
+			rawCompoundCurve[:HeaderSize+CountSize],
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CompoundCurve{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cc := CompoundCurve{}
+	if err := cc.Scan(rawCompoundCurve); assert.NoError(t, err) {
+		assert.Equal(t, CompoundCurve{
+			CircularString{{0, 0}, {1, 1}, {2, 0}},
+			LineString{{2, 0}, {4, 0}},
+		}, cc)
+	}
+
+	if raw, err := cc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCompoundCurve, raw)
+		assert.Len(t, raw, cc.ByteSize())
+	}
+}
+
+func TestMultiCurve(t *testing.T) {
+	if err := (&MultiCurve{}).Scan(rawCompoundCurve); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	mc := MultiCurve{}
+	if err := mc.Scan(rawMultiCurve); assert.NoError(t, err) {
+		assert.Equal(t, MultiCurve{
+			LineString{{2, 0}, {4, 0}},
+			CircularString{{0, 0}, {1, 1}, {2, 0}},
+		}, mc)
+	}
+
+	if raw, err := mc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiCurve, raw)
+		assert.Len(t, raw, mc.ByteSize())
+	}
+}
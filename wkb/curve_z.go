@@ -0,0 +1,395 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// Scan decodes geometry into cs, reusing its backing arrays as UnmarshalTo does.
+func (cs *CircularStringZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cs.UnmarshalBinary(b)
+}
+
+func (cs CircularStringZ) Value() (driver.Value, error) {
+	return cs.MarshalBinary()
+}
+
+func (cs CircularStringZ) MarshalBinary() ([]byte, error) {
+	return cs.AppendWKB(make([]byte, 0, cs.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into cs, reusing its backing arrays as UnmarshalTo does.
+func (cs *CircularStringZ) UnmarshalBinary(b []byte) error {
+	return cs.unmarshal(newDecoder(b), b)
+}
+
+func (cs *CircularStringZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.circularStringZ(b, reuse(d, b, *cs))
+	if err != nil {
+		return err
+	}
+
+	*cs = tmp
+	return nil
+}
+
+func ReadCircularStringZ(b []byte) ([]byte, CircularStringZ, error) {
+	return newDecoder(b).circularStringZ(b, nil)
+}
+
+func (d *decoder) circularStringZ(b []byte, dst CircularStringZ) ([]byte, CircularStringZ, error) {
+	b, dec, err := d.header(b, GeomCircularStringZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, pts, err := d.pointsZ(b, dec, PointsZ(dst))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !validArcs(len(pts)) {
+		return nil, nil, d.fail(b, ErrInvalidStorage)
+	}
+	return rest, CircularStringZ(pts), nil
+}
+
+func (cs CircularStringZ) IsEmpty() bool {
+	return len(cs) == 0
+}
+
+func (cs CircularStringZ) Kind() Kind {
+	return GeomCircularStringZ
+}
+
+func (cs CircularStringZ) Dimension() int {
+	return 1
+}
+
+// Envelope returns bounding box of arcs, which may extend past their control points.
+func (cs CircularStringZ) Envelope() Envelope {
+	return arcEnvelope(cs)
+}
+
+func (cs CircularStringZ) NumPoints() int {
+	return len(cs)
+}
+
+func (cs CircularStringZ) Equal(other Geometry) bool {
+	o, ok := other.(CircularStringZ)
+	return ok && equalPoints(cs, o)
+}
+
+// Centroid returns center of mass of linearized arcs, empty point when it is empty.
+func (cs CircularStringZ) Centroid() Point {
+	c := centroid{}
+	cs.addCentroid(&c)
+	return c.result()
+}
+
+func (cs CircularStringZ) addCentroid(c *centroid) {
+	cs.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cs CircularStringZ) addShape(s *shape) {
+	cs.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cs CircularStringZ) ByteSize() int {
+	return HeaderSize + PointsZ(cs).byteSize()
+}
+
+func (cs CircularStringZ) Write(buf *bytes.Buffer) {
+	buf.Write(cs.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cs CircularStringZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cs.encode(e)
+	return e.release()
+}
+
+func (cs CircularStringZ) encode(e *encoder) {
+	e.header(GeomCircularStringZ)
+	PointsZ(cs).encode(e)
+}
+
+func (cc *CompoundCurveZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cc.UnmarshalBinary(b)
+}
+
+func (cc CompoundCurveZ) Value() (driver.Value, error) {
+	return cc.MarshalBinary()
+}
+
+func (cc CompoundCurveZ) MarshalBinary() ([]byte, error) {
+	return cc.AppendWKB(make([]byte, 0, cc.ByteSize())), nil
+}
+
+func (cc *CompoundCurveZ) UnmarshalBinary(b []byte) error {
+	return cc.unmarshal(newDecoder(b), b)
+}
+
+func (cc *CompoundCurveZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.compoundCurveZ(b)
+	if err != nil {
+		return err
+	}
+
+	*cc = tmp
+	return nil
+}
+
+func ReadCompoundCurveZ(b []byte) ([]byte, CompoundCurveZ, error) {
+	return newDecoder(b).compoundCurveZ(b)
+}
+
+// compoundCurveZ reads curve made of line string and circular string segments.
+func (d *decoder) compoundCurveZ(b []byte) ([]byte, CompoundCurveZ, error) {
+	b, dec, err := d.header(b, GeomCompoundCurveZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomLineStringZ, GeomCircularStringZ)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (cc CompoundCurveZ) IsEmpty() bool {
+	return isEmpty(cc)
+}
+
+func (cc CompoundCurveZ) Kind() Kind {
+	return GeomCompoundCurveZ
+}
+
+func (cc CompoundCurveZ) Dimension() int {
+	return 1
+}
+
+func (cc CompoundCurveZ) Envelope() Envelope {
+	return envelopeAll(cc)
+}
+
+func (cc CompoundCurveZ) NumPoints() int {
+	return numPointsAll(cc)
+}
+
+func (cc CompoundCurveZ) Equal(other Geometry) bool {
+	o, ok := other.(CompoundCurveZ)
+	return ok && equalAll(cc, o)
+}
+
+// Centroid returns center of mass of linearized curve, empty point when it is empty.
+func (cc CompoundCurveZ) Centroid() Point {
+	c := centroid{}
+	cc.addCentroid(&c)
+	return c.result()
+}
+
+func (cc CompoundCurveZ) addCentroid(c *centroid) {
+	cc.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cc CompoundCurveZ) addShape(s *shape) {
+	cc.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cc CompoundCurveZ) ByteSize() int {
+	return membersSize(cc)
+}
+
+func (cc CompoundCurveZ) Write(buf *bytes.Buffer) {
+	buf.Write(cc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cc CompoundCurveZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cc.encode(e)
+	return e.release()
+}
+
+func (cc CompoundCurveZ) encode(e *encoder) {
+	e.members(GeomCompoundCurveZ, cc)
+}
+
+func (mc *MultiCurveZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mc.UnmarshalBinary(b)
+}
+
+func (mc MultiCurveZ) Value() (driver.Value, error) {
+	return mc.MarshalBinary()
+}
+
+func (mc MultiCurveZ) MarshalBinary() ([]byte, error) {
+	return mc.AppendWKB(make([]byte, 0, mc.ByteSize())), nil
+}
+
+func (mc *MultiCurveZ) UnmarshalBinary(b []byte) error {
+	return mc.unmarshal(newDecoder(b), b)
+}
+
+func (mc *MultiCurveZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiCurveZ(b)
+	if err != nil {
+		return err
+	}
+
+	*mc = tmp
+	return nil
+}
+
+func ReadMultiCurveZ(b []byte) ([]byte, MultiCurveZ, error) {
+	return newDecoder(b).multiCurveZ(b)
+}
+
+func (d *decoder) multiCurveZ(b []byte) ([]byte, MultiCurveZ, error) {
+	b, dec, err := d.header(b, GeomMultiCurveZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomLineStringZ, GeomCircularStringZ, GeomCompoundCurveZ)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (mc MultiCurveZ) IsEmpty() bool {
+	return isEmpty(mc)
+}
+
+func (mc MultiCurveZ) Kind() Kind {
+	return GeomMultiCurveZ
+}
+
+func (mc MultiCurveZ) Dimension() int {
+	return 1
+}
+
+func (mc MultiCurveZ) Envelope() Envelope {
+	return envelopeAll(mc)
+}
+
+func (mc MultiCurveZ) NumPoints() int {
+	return numPointsAll(mc)
+}
+
+func (mc MultiCurveZ) Equal(other Geometry) bool {
+	o, ok := other.(MultiCurveZ)
+	return ok && equalAll(mc, o)
+}
+
+// Centroid returns center of mass of linearized curves, empty point when it is empty.
+func (mc MultiCurveZ) Centroid() Point {
+	c := centroid{}
+	mc.addCentroid(&c)
+	return c.result()
+}
+
+func (mc MultiCurveZ) addCentroid(c *centroid) {
+	mc.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (mc MultiCurveZ) addShape(s *shape) {
+	mc.Linearize(DefaultSegments).addShape(s)
+}
+
+func (mc MultiCurveZ) ByteSize() int {
+	return membersSize(mc)
+}
+
+func (mc MultiCurveZ) Write(buf *bytes.Buffer) {
+	buf.Write(mc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mc MultiCurveZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mc.encode(e)
+	return e.release()
+}
+
+func (mc MultiCurveZ) encode(e *encoder) {
+	e.members(GeomMultiCurveZ, mc)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+// Points not forming whole arc, left over when number of points is invalid, are joined with straight segments.
+func (cs CircularStringZ) Linearize(segments int) LineStringZ {
+	if len(cs) == 0 {
+		return LineStringZ{}
+	}
+
+	ls := LineStringZ{cs[0]}
+	i := 2
+	for ; i < len(cs); i += 2 {
+		p0, p1, p2 := cs[i-2], cs[i-1], cs[i]
+		for _, a := range arc(p0.X, p0.Y, p1.X, p1.Y, p2.X, p2.Y, segments) {
+			ls = append(ls, PointZ{a.x, a.y, interpolate(a.t, p0.Z, p1.Z, p2.Z)})
+		}
+	}
+	return append(ls, cs[i-1:]...)
+}
+
+func (cs CircularStringZ) linearize(segments int) Geometry {
+	return cs.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+// Points shared by consecutive members are not repeated.
+func (cc CompoundCurveZ) Linearize(segments int) LineStringZ {
+	ls := LineStringZ{}
+	for _, g := range cc {
+		part := linearizeCurveZ(g, segments)
+		if len(ls) > 0 && len(part) > 0 && equalPoint(ls[len(ls)-1], part[0]) {
+			part = part[1:]
+		}
+		ls = append(ls, part...)
+	}
+	return ls
+}
+
+func (cc CompoundCurveZ) linearize(segments int) Geometry {
+	return cc.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (mc MultiCurveZ) Linearize(segments int) MultiLineStringZ {
+	mls := make(MultiLineStringZ, len(mc))
+	for i, g := range mc {
+		mls[i] = linearizeCurveZ(g, segments)
+	}
+	return mls
+}
+
+func (mc MultiCurveZ) linearize(segments int) Geometry {
+	return mc.Linearize(segments)
+}
+
+func linearizeCurveZ(g Geometry, segments int) LineStringZ {
+	switch c := g.(type) {
+	case LineStringZ:
+		return c
+	case CircularStringZ:
+		return c.Linearize(segments)
+	case CompoundCurveZ:
+		return c.Linearize(segments)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,177 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawCircularStringZ = []byte{
+		0x01, 0xf0, 0x03, 0x00, 0x00, // header
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	}
+	rawCompoundCurveZ = []byte{
+		0x01, 0xf1, 0x03, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numcurves - 2
+		0x01, 0xf0, 0x03, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x01, 0xea, 0x03, 0x00, 0x00, // linestring
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+	}
+	rawMultiCurveZ = []byte{
+		0x01, 0xf3, 0x03, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numcurves - 2
+		0x01, 0xea, 0x03, 0x00, 0x00, // linestring
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+		0x01, 0xf0, 0x03, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	}
+)
+
+func TestCircularStringZ(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawLineStringZ,
+		},
+		{
+			// no points
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0xf0, 0x03, 0x00, 0x00, // header
+				0x03, 0x00, 0x00, 0x00, // numpoints - 3
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CircularStringZ{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cs := CircularStringZ{}
+	if err := cs.Scan(rawCircularStringZ); assert.NoError(t, err) {
+		assert.Equal(t, CircularStringZ{{0, 0, 5}, {1, 1, 6}, {2, 0, 7}}, cs)
+	}
+
+	if raw, err := cs.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCircularStringZ, raw)
+		assert.Len(t, raw, cs.ByteSize())
+	}
+}
+
+func TestCompoundCurveZ(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawMultiCurveZ,
+		},
+		{
+			// invalid member type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0xf1, 0x03, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numcurves - 1
+				0x01, 0xe9, 0x03, 0x00, 0x00, // point
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+			},
+		},
+		{
+			// no members
+			ErrInvalidStorage,
+			rawCompoundCurveZ[:HeaderSize+CountSize],
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CompoundCurveZ{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cc := CompoundCurveZ{}
+	if err := cc.Scan(rawCompoundCurveZ); assert.NoError(t, err) {
+		assert.Equal(t, CompoundCurveZ{
+			CircularStringZ{{0, 0, 5}, {1, 1, 6}, {2, 0, 7}},
+			LineStringZ{{2, 0, 7}, {4, 0, 8}},
+		}, cc)
+	}
+
+	if raw, err := cc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCompoundCurveZ, raw)
+		assert.Len(t, raw, cc.ByteSize())
+	}
+}
+
+func TestMultiCurveZ(t *testing.T) {
+	if err := (&MultiCurveZ{}).Scan(rawCompoundCurveZ); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	mc := MultiCurveZ{}
+	if err := mc.Scan(rawMultiCurveZ); assert.NoError(t, err) {
+		assert.Equal(t, MultiCurveZ{
+			LineStringZ{{2, 0, 7}, {4, 0, 8}},
+			CircularStringZ{{0, 0, 5}, {1, 1, 6}, {2, 0, 7}},
+		}, mc)
+	}
+
+	if raw, err := mc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiCurveZ, raw)
+		assert.Len(t, raw, mc.ByteSize())
+	}
+}
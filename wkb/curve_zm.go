@@ -0,0 +1,395 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// Scan decodes geometry into cs, reusing its backing arrays as UnmarshalTo does.
+func (cs *CircularStringZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cs.UnmarshalBinary(b)
+}
+
+func (cs CircularStringZM) Value() (driver.Value, error) {
+	return cs.MarshalBinary()
+}
+
+func (cs CircularStringZM) MarshalBinary() ([]byte, error) {
+	return cs.AppendWKB(make([]byte, 0, cs.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into cs, reusing its backing arrays as UnmarshalTo does.
+func (cs *CircularStringZM) UnmarshalBinary(b []byte) error {
+	return cs.unmarshal(newDecoder(b), b)
+}
+
+func (cs *CircularStringZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.circularStringZM(b, reuse(d, b, *cs))
+	if err != nil {
+		return err
+	}
+
+	*cs = tmp
+	return nil
+}
+
+func ReadCircularStringZM(b []byte) ([]byte, CircularStringZM, error) {
+	return newDecoder(b).circularStringZM(b, nil)
+}
+
+func (d *decoder) circularStringZM(b []byte, dst CircularStringZM) ([]byte, CircularStringZM, error) {
+	b, dec, err := d.header(b, GeomCircularStringZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, pts, err := d.pointsZM(b, dec, PointsZM(dst))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !validArcs(len(pts)) {
+		return nil, nil, d.fail(b, ErrInvalidStorage)
+	}
+	return rest, CircularStringZM(pts), nil
+}
+
+func (cs CircularStringZM) IsEmpty() bool {
+	return len(cs) == 0
+}
+
+func (cs CircularStringZM) Kind() Kind {
+	return GeomCircularStringZM
+}
+
+func (cs CircularStringZM) Dimension() int {
+	return 1
+}
+
+// Envelope returns bounding box of arcs, which may extend past their control points.
+func (cs CircularStringZM) Envelope() Envelope {
+	return arcEnvelope(cs)
+}
+
+func (cs CircularStringZM) NumPoints() int {
+	return len(cs)
+}
+
+func (cs CircularStringZM) Equal(other Geometry) bool {
+	o, ok := other.(CircularStringZM)
+	return ok && equalPoints(cs, o)
+}
+
+// Centroid returns center of mass of linearized arcs, empty point when it is empty.
+func (cs CircularStringZM) Centroid() Point {
+	c := centroid{}
+	cs.addCentroid(&c)
+	return c.result()
+}
+
+func (cs CircularStringZM) addCentroid(c *centroid) {
+	cs.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cs CircularStringZM) addShape(s *shape) {
+	cs.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cs CircularStringZM) ByteSize() int {
+	return HeaderSize + PointsZM(cs).byteSize()
+}
+
+func (cs CircularStringZM) Write(buf *bytes.Buffer) {
+	buf.Write(cs.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cs CircularStringZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cs.encode(e)
+	return e.release()
+}
+
+func (cs CircularStringZM) encode(e *encoder) {
+	e.header(GeomCircularStringZM)
+	PointsZM(cs).encode(e)
+}
+
+func (cc *CompoundCurveZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cc.UnmarshalBinary(b)
+}
+
+func (cc CompoundCurveZM) Value() (driver.Value, error) {
+	return cc.MarshalBinary()
+}
+
+func (cc CompoundCurveZM) MarshalBinary() ([]byte, error) {
+	return cc.AppendWKB(make([]byte, 0, cc.ByteSize())), nil
+}
+
+func (cc *CompoundCurveZM) UnmarshalBinary(b []byte) error {
+	return cc.unmarshal(newDecoder(b), b)
+}
+
+func (cc *CompoundCurveZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.compoundCurveZM(b)
+	if err != nil {
+		return err
+	}
+
+	*cc = tmp
+	return nil
+}
+
+func ReadCompoundCurveZM(b []byte) ([]byte, CompoundCurveZM, error) {
+	return newDecoder(b).compoundCurveZM(b)
+}
+
+// compoundCurveZM reads curve made of line string and circular string segments.
+func (d *decoder) compoundCurveZM(b []byte) ([]byte, CompoundCurveZM, error) {
+	b, dec, err := d.header(b, GeomCompoundCurveZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomLineStringZM, GeomCircularStringZM)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (cc CompoundCurveZM) IsEmpty() bool {
+	return isEmpty(cc)
+}
+
+func (cc CompoundCurveZM) Kind() Kind {
+	return GeomCompoundCurveZM
+}
+
+func (cc CompoundCurveZM) Dimension() int {
+	return 1
+}
+
+func (cc CompoundCurveZM) Envelope() Envelope {
+	return envelopeAll(cc)
+}
+
+func (cc CompoundCurveZM) NumPoints() int {
+	return numPointsAll(cc)
+}
+
+func (cc CompoundCurveZM) Equal(other Geometry) bool {
+	o, ok := other.(CompoundCurveZM)
+	return ok && equalAll(cc, o)
+}
+
+// Centroid returns center of mass of linearized curve, empty point when it is empty.
+func (cc CompoundCurveZM) Centroid() Point {
+	c := centroid{}
+	cc.addCentroid(&c)
+	return c.result()
+}
+
+func (cc CompoundCurveZM) addCentroid(c *centroid) {
+	cc.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cc CompoundCurveZM) addShape(s *shape) {
+	cc.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cc CompoundCurveZM) ByteSize() int {
+	return membersSize(cc)
+}
+
+func (cc CompoundCurveZM) Write(buf *bytes.Buffer) {
+	buf.Write(cc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cc CompoundCurveZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cc.encode(e)
+	return e.release()
+}
+
+func (cc CompoundCurveZM) encode(e *encoder) {
+	e.members(GeomCompoundCurveZM, cc)
+}
+
+func (mc *MultiCurveZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mc.UnmarshalBinary(b)
+}
+
+func (mc MultiCurveZM) Value() (driver.Value, error) {
+	return mc.MarshalBinary()
+}
+
+func (mc MultiCurveZM) MarshalBinary() ([]byte, error) {
+	return mc.AppendWKB(make([]byte, 0, mc.ByteSize())), nil
+}
+
+func (mc *MultiCurveZM) UnmarshalBinary(b []byte) error {
+	return mc.unmarshal(newDecoder(b), b)
+}
+
+func (mc *MultiCurveZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiCurveZM(b)
+	if err != nil {
+		return err
+	}
+
+	*mc = tmp
+	return nil
+}
+
+func ReadMultiCurveZM(b []byte) ([]byte, MultiCurveZM, error) {
+	return newDecoder(b).multiCurveZM(b)
+}
+
+func (d *decoder) multiCurveZM(b []byte) ([]byte, MultiCurveZM, error) {
+	b, dec, err := d.header(b, GeomMultiCurveZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomLineStringZM, GeomCircularStringZM, GeomCompoundCurveZM)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (mc MultiCurveZM) IsEmpty() bool {
+	return isEmpty(mc)
+}
+
+func (mc MultiCurveZM) Kind() Kind {
+	return GeomMultiCurveZM
+}
+
+func (mc MultiCurveZM) Dimension() int {
+	return 1
+}
+
+func (mc MultiCurveZM) Envelope() Envelope {
+	return envelopeAll(mc)
+}
+
+func (mc MultiCurveZM) NumPoints() int {
+	return numPointsAll(mc)
+}
+
+func (mc MultiCurveZM) Equal(other Geometry) bool {
+	o, ok := other.(MultiCurveZM)
+	return ok && equalAll(mc, o)
+}
+
+// Centroid returns center of mass of linearized curves, empty point when it is empty.
+func (mc MultiCurveZM) Centroid() Point {
+	c := centroid{}
+	mc.addCentroid(&c)
+	return c.result()
+}
+
+func (mc MultiCurveZM) addCentroid(c *centroid) {
+	mc.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (mc MultiCurveZM) addShape(s *shape) {
+	mc.Linearize(DefaultSegments).addShape(s)
+}
+
+func (mc MultiCurveZM) ByteSize() int {
+	return membersSize(mc)
+}
+
+func (mc MultiCurveZM) Write(buf *bytes.Buffer) {
+	buf.Write(mc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mc MultiCurveZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mc.encode(e)
+	return e.release()
+}
+
+func (mc MultiCurveZM) encode(e *encoder) {
+	e.members(GeomMultiCurveZM, mc)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+// Points not forming whole arc, left over when number of points is invalid, are joined with straight segments.
+func (cs CircularStringZM) Linearize(segments int) LineStringZM {
+	if len(cs) == 0 {
+		return LineStringZM{}
+	}
+
+	ls := LineStringZM{cs[0]}
+	i := 2
+	for ; i < len(cs); i += 2 {
+		p0, p1, p2 := cs[i-2], cs[i-1], cs[i]
+		for _, a := range arc(p0.X, p0.Y, p1.X, p1.Y, p2.X, p2.Y, segments) {
+			ls = append(ls, PointZM{a.x, a.y, interpolate(a.t, p0.Z, p1.Z, p2.Z), interpolate(a.t, p0.M, p1.M, p2.M)})
+		}
+	}
+	return append(ls, cs[i-1:]...)
+}
+
+func (cs CircularStringZM) linearize(segments int) Geometry {
+	return cs.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+// Points shared by consecutive members are not repeated.
+func (cc CompoundCurveZM) Linearize(segments int) LineStringZM {
+	ls := LineStringZM{}
+	for _, g := range cc {
+		part := linearizeCurveZM(g, segments)
+		if len(ls) > 0 && len(part) > 0 && equalPoint(ls[len(ls)-1], part[0]) {
+			part = part[1:]
+		}
+		ls = append(ls, part...)
+	}
+	return ls
+}
+
+func (cc CompoundCurveZM) linearize(segments int) Geometry {
+	return cc.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (mc MultiCurveZM) Linearize(segments int) MultiLineStringZM {
+	mls := make(MultiLineStringZM, len(mc))
+	for i, g := range mc {
+		mls[i] = linearizeCurveZM(g, segments)
+	}
+	return mls
+}
+
+func (mc MultiCurveZM) linearize(segments int) Geometry {
+	return mc.Linearize(segments)
+}
+
+func linearizeCurveZM(g Geometry, segments int) LineStringZM {
+	switch c := g.(type) {
+	case LineStringZM:
+		return c
+	case CircularStringZM:
+		return c.Linearize(segments)
+	case CompoundCurveZM:
+		return c.Linearize(segments)
+	default:
+		return nil
+	}
+}
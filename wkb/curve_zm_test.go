@@ -0,0 +1,192 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawCircularStringZM = []byte{
+		0x01, 0xc0, 0x0b, 0x00, 0x00, // header
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22, 0x40,
+	}
+	rawCompoundCurveZM = []byte{
+		0x01, 0xc1, 0x0b, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numcurves - 2
+		0x01, 0xc0, 0x0b, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22, 0x40,
+		0x01, 0xba, 0x0b, 0x00, 0x00, // linestring
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+	}
+	rawMultiCurveZM = []byte{
+		0x01, 0xc3, 0x0b, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numcurves - 2
+		0x01, 0xba, 0x0b, 0x00, 0x00, // linestring
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x01, 0xc0, 0x0b, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22, 0x40,
+	}
+)
+
+func TestCircularStringZM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawLineStringZM,
+		},
+		{
+			// no points
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0xc0, 0x0b, 0x00, 0x00, // header
+				0x03, 0x00, 0x00, 0x00, // numpoints - 3
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CircularStringZM{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cs := CircularStringZM{}
+	if err := cs.Scan(rawCircularStringZM); assert.NoError(t, err) {
+		assert.Equal(t, CircularStringZM{{0, 0, 5, 7}, {1, 1, 6, 8}, {2, 0, 7, 9}}, cs)
+	}
+
+	if raw, err := cs.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCircularStringZM, raw)
+		assert.Len(t, raw, cs.ByteSize())
+	}
+}
+
+func TestCompoundCurveZM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawMultiCurveZM,
+		},
+		{
+			// invalid member type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0xc1, 0x0b, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numcurves - 1
+				0x01, 0xb9, 0x0b, 0x00, 0x00, // point
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+			},
+		},
+		{
+			// no members
+			ErrInvalidStorage,
+			rawCompoundCurveZM[:HeaderSize+CountSize],
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CompoundCurveZM{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cc := CompoundCurveZM{}
+	if err := cc.Scan(rawCompoundCurveZM); assert.NoError(t, err) {
+		assert.Equal(t, CompoundCurveZM{
+			CircularStringZM{{0, 0, 5, 7}, {1, 1, 6, 8}, {2, 0, 7, 9}},
+			LineStringZM{{2, 0, 7, 9}, {4, 0, 8, 10}},
+		}, cc)
+	}
+
+	if raw, err := cc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCompoundCurveZM, raw)
+		assert.Len(t, raw, cc.ByteSize())
+	}
+}
+
+func TestMultiCurveZM(t *testing.T) {
+	if err := (&MultiCurveZM{}).Scan(rawCompoundCurveZM); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	mc := MultiCurveZM{}
+	if err := mc.Scan(rawMultiCurveZM); assert.NoError(t, err) {
+		assert.Equal(t, MultiCurveZM{
+			LineStringZM{{2, 0, 7, 9}, {4, 0, 8, 10}},
+			CircularStringZM{{0, 0, 5, 7}, {1, 1, 6, 8}, {2, 0, 7, 9}},
+		}, mc)
+	}
+
+	if raw, err := mc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiCurveZM, raw)
+		assert.Len(t, raw, mc.ByteSize())
+	}
+}
@@ -0,0 +1,430 @@
+package wkb
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+)
+
+// Limits bounds resources spent decoding untrusted input, zero field means no limit.
+type Limits struct {
+	// MaxBytes is maximum size of decoded input.
+	MaxBytes int
+	// MaxElements is maximum total of counts in geometry: points, rings and members.
+	MaxElements int
+	// MaxDepth is maximum nesting of geometry collections and curves or surfaces made of other geometries.
+	MaxDepth int
+}
+
+// DefaultLimits apply to Read functions, New and Scan.
+var DefaultLimits = Limits{MaxDepth: 64}
+
+type decoder struct {
+	size     int
+	limits   Limits
+	elements int
+	depth    int
+
+	expected, actual Kind
+
+	// path is kept in array for common nesting depths, so that decoder needs no allocation,
+	// deeper elements spill to pathMore.
+	path     [8]pathElem
+	pathLen  int
+	pathMore []pathElem
+}
+
+type pathElem struct {
+	label string
+	kind  Kind
+	index int
+}
+
+// DecoderOption configures decoding of geometries.
+type DecoderOption func(*decoder)
+
+// WithLimits replaces DefaultLimits for decoding.
+func WithLimits(limits Limits) DecoderOption {
+	return func(d *decoder) {
+		d.limits = limits
+	}
+}
+
+func newDecoder(b []byte, opts ...DecoderOption) *decoder {
+	return &decoder{
+		size:   len(b),
+		limits: decoderLimits(opts),
+	}
+}
+
+// decoderLimits applies options to separate decoder, so that one being returned by newDecoder
+// can stay off heap when options are not used.
+func decoderLimits(opts []DecoderOption) Limits {
+	if len(opts) == 0 {
+		return DefaultLimits
+	}
+
+	d := &decoder{limits: DefaultLimits}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d.limits
+}
+
+// Unmarshal decodes WKB geometry of any kind.
+func Unmarshal(b []byte, opts ...DecoderOption) (Geometry, error) {
+	_, g, err := newDecoder(b, opts...).geometry(b)
+	return g, err
+}
+
+type unmarshaler interface {
+	unmarshal(d *decoder, b []byte) error
+}
+
+// UnmarshalTo decodes WKB geometry into dst, reusing backing arrays of its slices when large enough.
+// Geometry previously held by dst and slices sharing its arrays are overwritten and must be copied to be retained.
+// Scan and UnmarshalBinary of slice-backed geometries reuse them the same way. When decoding fails dst is left unchanged.
+func UnmarshalTo(b []byte, dst encoding.BinaryUnmarshaler, opts ...DecoderOption) error {
+	if u, ok := dst.(unmarshaler); ok {
+		return u.unmarshal(newDecoder(b, opts...), b)
+	}
+	return dst.UnmarshalBinary(b)
+}
+
+func (d *decoder) header(b []byte, tpe Kind) ([]byte, binary.ByteOrder, error) {
+	d.root(tpe)
+	d.expected, d.actual = tpe, 0
+
+	if d.limits.MaxBytes > 0 && d.size > d.limits.MaxBytes {
+		return nil, nil, d.fail(b, ErrLimitExceeded)
+	}
+
+	if len(b) < HeaderSize {
+		return nil, nil, d.fail(b, ErrInvalidStorage)
+	}
+
+	dec := byteOrder(b[0])
+	if dec == nil {
+		return nil, nil, d.fail(b, ErrUnsupportedValue)
+	}
+
+	rest, code := readUint32(b[ByteOrderSize:], dec)
+	d.actual = kind(code)
+	if tpe != d.actual {
+		return nil, nil, d.fail(b, ErrUnsupportedValue)
+	}
+
+	if code&ewkbSRID != 0 {
+		if len(rest) < SRIDSize {
+			return nil, nil, d.fail(rest, ErrInvalidStorage)
+		}
+		rest = rest[SRIDSize:]
+	}
+
+	return rest, dec, nil
+}
+
+// count reads number of elements, each taking at least size bytes of remaining input.
+// Zero size leaves bounds check to caller.
+func (d *decoder) count(b []byte, dec binary.ByteOrder, size int) ([]byte, int, error) {
+	if len(b) < CountSize {
+		return nil, 0, d.fail(b, ErrInvalidStorage)
+	}
+
+	rest, n := readCount(b, dec)
+	if n < 0 || size > 0 && n > len(rest)/size {
+		return nil, 0, d.fail(b, ErrInvalidStorage)
+	}
+
+	d.elements += n
+	if d.limits.MaxElements > 0 && d.elements > d.limits.MaxElements {
+		return nil, 0, d.fail(b, ErrLimitExceeded)
+	}
+	return rest, n, nil
+}
+
+// members reads nested geometries, each being one of given kinds.
+func (d *decoder) members(b []byte, dec binary.ByteOrder, label string, kinds ...Kind) ([]byte, []Geometry, error) {
+	b, n, err := d.count(b, dec, HeaderSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !d.enter() {
+		return nil, nil, d.fail(b, ErrLimitExceeded)
+	}
+	defer d.leave()
+
+	gs := make([]Geometry, n)
+	d.push(label)
+	for i := 0; i < n; i++ {
+		d.at(i)
+		if len(b) >= HeaderSize && byteOrder(b[0]) != nil {
+			k := kind(byteOrder(b[0]).Uint32(b[ByteOrderSize:]))
+			if !hasKind(kinds, k) {
+				d.expected, d.actual = kinds[0], k
+				return nil, nil, d.fail(b, ErrUnsupportedValue)
+			}
+		}
+
+		if b, gs[i], err = d.geometry(b); err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, gs, nil
+}
+
+func hasKind(kinds []Kind, k Kind) bool {
+	for _, kk := range kinds {
+		if kk == k {
+			return true
+		}
+	}
+	return false
+}
+
+// enter increases nesting depth, reporting whether it is within limit.
+func (d *decoder) enter() bool {
+	d.depth++
+	return d.limits.MaxDepth <= 0 || d.depth <= d.limits.MaxDepth
+}
+
+func (d *decoder) leave() {
+	d.depth--
+}
+
+// push starts path element with given label, index is set by at for each member.
+func (d *decoder) push(label string) {
+	d.pushElem(pathElem{label: label, index: -1})
+}
+
+// root starts path with kind of top level geometry.
+func (d *decoder) root(k Kind) {
+	if d.pathLen == 0 {
+		d.pushElem(pathElem{kind: k, index: -1})
+	}
+}
+
+func (d *decoder) pushElem(e pathElem) {
+	if d.pathLen < len(d.path) {
+		d.path[d.pathLen] = e
+	} else {
+		d.pathMore = append(d.pathMore[:d.pathLen-len(d.path)], e)
+	}
+	d.pathLen++
+}
+
+func (d *decoder) elem(i int) *pathElem {
+	if i < len(d.path) {
+		return &d.path[i]
+	}
+	return &d.pathMore[i-len(d.path)]
+}
+
+func (d *decoder) at(i int) {
+	d.elem(d.pathLen - 1).index = i
+}
+
+func (d *decoder) pop() {
+	d.pathLen--
+}
+
+// fail reports err at start of b within decoded input.
+func (d *decoder) fail(b []byte, err error) error {
+	path := bytes.Buffer{}
+	for i := 0; i < d.pathLen; i++ {
+		e := d.elem(i)
+		label := e.label
+		if e.kind != 0 {
+			label = e.kind.String()
+		}
+		if label != "" {
+			if i > 0 {
+				path.WriteByte('.')
+			}
+			path.WriteString(label)
+		}
+		if e.index >= 0 {
+			fmt.Fprintf(&path, "[%d]", e.index)
+		}
+	}
+
+	return &DecodeError{
+		Offset:   d.size - len(b),
+		Expected: d.expected,
+		Actual:   d.actual,
+		Path:     path.String(),
+		Err:      err,
+	}
+}
+
+// DecodeError describes where decoding failed, it matches ErrInvalidStorage,
+// ErrUnsupportedValue or ErrLimitExceeded with errors.Is.
+type DecodeError struct {
+	// Offset is position in input where decoding failed.
+	Offset int
+	// Expected is kind being decoded, zero when any kind is accepted.
+	Expected Kind
+	// Actual is kind read from input, zero when not read yet.
+	Actual Kind
+	// Path locates failing element, such as "MultiPolygon[3].ring[1].point[17]".
+	Path string
+	Err  error
+}
+
+func (e *DecodeError) Error() string {
+	msg := fmt.Sprintf("%s at offset %d", e.Err, e.Offset)
+	if e.Path != "" {
+		msg += " in " + e.Path
+	}
+	if e.Actual != 0 && e.Expected != e.Actual {
+		if e.Expected != 0 {
+			msg += fmt.Sprintf(": expected %s, got %s", e.Expected, e.Actual)
+		} else {
+			msg += ": got " + e.Actual.String()
+		}
+	}
+	return msg
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// grow returns s resized to n elements, reusing its backing array when large enough.
+func grow[S ~[]E, E any](s S, n int) S {
+	if s != nil && cap(s) >= n {
+		return s[:n]
+	}
+	return make(S, n)
+}
+
+// reuse returns dst when b decodes into geometry of its kind, nil otherwise.
+// Decoding overwrites elements of dst in place, so failing one must not start with them.
+func reuse[S interface {
+	~[]E
+	Kind() Kind
+}, E any](d *decoder, b []byte, dst S) S {
+	if len(dst) == 0 || d.decodes(b, dst.Kind()) {
+		return dst
+	}
+	return nil
+}
+
+// decodes reports whether geometry of kind k at start of b passes checks of decoders which reuse storage:
+// header, counts, sizes, limits, arcs of circular strings and rings of triangles.
+func (d *decoder) decodes(b []byte, k Kind) bool {
+	elements := d.elements
+	_, ok := d.decodesGeometry(b, k, &elements)
+	return ok
+}
+
+func (d *decoder) decodesGeometry(b []byte, k Kind, elements *int) ([]byte, bool) {
+	if d.limits.MaxBytes > 0 && d.size > d.limits.MaxBytes || len(b) < HeaderSize {
+		return nil, false
+	}
+	dec := byteOrder(b[0])
+	if dec == nil {
+		return nil, false
+	}
+	b, code := readUint32(b[ByteOrderSize:], dec)
+	if kind(code) != k {
+		return nil, false
+	}
+	if code&ewkbSRID != 0 {
+		if len(b) < SRIDSize {
+			return nil, false
+		}
+		b = b[SRIDSize:]
+	}
+
+	size, ok := coordSize(k)
+	if !ok {
+		return nil, false
+	}
+
+	var n int
+	switch k % 1000 {
+	case GeomPoint:
+		if len(b) < size {
+			return nil, false
+		}
+		return b[size:], true
+	case GeomLineString, GeomCircularString:
+		if b, n, ok = d.decodesCount(b, dec, size, elements); !ok || k%1000 == GeomCircularString && !validArcs(n) {
+			return nil, false
+		}
+		return b[n*size:], true
+	case GeomPolygon, GeomTriangle:
+		if b, n, ok = d.decodesCount(b, dec, CountSize, elements); !ok || k%1000 == GeomTriangle && n > 1 {
+			return nil, false
+		}
+		for i := 0; i < n; i++ {
+			var m int
+			if b, m, ok = d.decodesCount(b, dec, size, elements); !ok || k%1000 == GeomTriangle && !closedTriangle(b, dec, m, size) {
+				return nil, false
+			}
+			b = b[m*size:]
+		}
+		return b, true
+	}
+
+	var member Kind
+	switch k % 1000 {
+	case GeomMultiPoint:
+		member = GeomPoint
+	case GeomMultiLineString:
+		member = GeomLineString
+	case GeomMultiPolygon, GeomPolyhedralSurface:
+		member = GeomPolygon
+	case GeomTIN:
+		member = GeomTriangle
+	default:
+		return nil, false
+	}
+
+	least := HeaderSize + CountSize
+	if member == GeomPoint {
+		least = HeaderSize + size
+	}
+	if b, n, ok = d.decodesCount(b, dec, least, elements); !ok {
+		return nil, false
+	}
+	for i := 0; i < n; i++ {
+		if b, ok = d.decodesGeometry(b, member+k/1000*1000, elements); !ok {
+			return nil, false
+		}
+	}
+	return b, true
+}
+
+// decodesCount mirrors count, adding to elements instead of decoder.
+func (d *decoder) decodesCount(b []byte, dec binary.ByteOrder, size int, elements *int) ([]byte, int, bool) {
+	if len(b) < CountSize {
+		return nil, 0, false
+	}
+	rest, n := readCount(b, dec)
+	*elements += n
+	if n < 0 || n > len(rest)/size || d.limits.MaxElements > 0 && *elements > d.limits.MaxElements {
+		return nil, 0, false
+	}
+	return rest, n, true
+}
+
+// closedTriangle reports whether ring of m coordinates of given size at start of b is valid ring of triangle.
+func closedTriangle(b []byte, dec binary.ByteOrder, m, size int) bool {
+	if m != 4 {
+		return false
+	}
+	for off := 0; off < size; off += Float64Size {
+		_, first := readFloat64(b[off:], dec)
+		_, last := readFloat64(b[3*size+off:], dec)
+		if first != last {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,215 @@
+package wkb
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// nestedCollection returns geometry collections nested n times around empty collection,
+// with nested headers starting with given marker.
+func nestedCollection(n int, marker byte) []byte {
+	b := []byte{}
+	for i := 0; i < n; i++ {
+		b = append(b, marker, 0x07, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00)
+	}
+	b = append(b, marker, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00)
+	b[0] = LittleEndian
+	return b
+}
+
+func TestDecoderHostileCounts(t *testing.T) {
+	hostile := [][]byte{
+		{0x01, 0x02, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff}, // linestring
+		{0x01, 0x03, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff}, // polygon
+		{0x01, 0x04, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff}, // multipoint
+		{0x01, 0x05, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff}, // multilinestring
+		{0x01, 0x06, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff}, // multipolygon
+		{0x01, 0x07, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff}, // geometry collection
+		{0x01, 0xbf, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff}, // geometry collection zm
+		{
+			// polygon with ring count exceeding input
+			0x01, 0x03, 0x00, 0x00, 0x00,
+			0x01, 0x00, 0x00, 0x00,
+			0xff, 0xff, 0xff, 0x0f,
+		},
+		{
+			// multipoint with single point claiming many members
+			0x01, 0x04, 0x00, 0x00, 0x00,
+			0x02, 0x00, 0x00, 0x00,
+			0x01, 0x01, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		},
+	}
+
+	for _, b := range hostile {
+		_, err := New(b)
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+
+		_, err = NewDecoder(bytes.NewReader(b)).Decode()
+		assert.Error(t, err)
+	}
+}
+
+func TestDecoderLimits(t *testing.T) {
+	deep := nestedCollection(DefaultLimits.MaxDepth, LittleEndian)
+	_, err := New(deep)
+	assert.ErrorIs(t, err, ErrLimitExceeded)
+	_, err = NewDecoder(bytes.NewReader(deep)).Decode()
+	assert.ErrorIs(t, err, ErrLimitExceeded)
+	_, _, err = ReadSpatialite(append(append([]byte{
+		0x00, 0x01,
+		0xe6, 0x10, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x7c,
+	}, nestedCollection(DefaultLimits.MaxDepth, spatialiteEntity)[ByteOrderSize:]...), spatialiteEnd))
+	assert.ErrorIs(t, err, ErrLimitExceeded)
+
+	shallow := nestedCollection(DefaultLimits.MaxDepth-1, LittleEndian)
+	_, err = New(shallow)
+	assert.NoError(t, err)
+	_, err = NewDecoder(bytes.NewReader(shallow)).Decode()
+	assert.NoError(t, err)
+
+	_, err = Unmarshal(nestedCollection(3, LittleEndian), WithLimits(Limits{MaxDepth: 3}))
+	assert.ErrorIs(t, err, ErrLimitExceeded)
+	_, err = Unmarshal(nestedCollection(3, LittleEndian), WithLimits(Limits{}))
+	assert.NoError(t, err)
+
+	limits := []Limits{
+		{MaxBytes: len(rawMultiPolygon) - 1},
+		{MaxElements: 12},
+	}
+	for _, l := range limits {
+		_, err = Unmarshal(rawMultiPolygon, WithLimits(l))
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+
+		_, err = NewDecoder(bytes.NewReader(rawMultiPolygon), WithLimits(l)).Decode()
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+	}
+
+	g, err := Unmarshal(rawMultiPolygon, WithLimits(Limits{MaxBytes: len(rawMultiPolygon), MaxElements: 13}))
+	if assert.NoError(t, err) {
+		assert.IsType(t, MultiPolygon{}, g)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	mismatch := []byte{
+		0x01, 0x04, 0x00, 0x00, 0x00,
+		0x01, 0x00, 0x00, 0x00,
+		0x01, 0x02, 0x00, 0x00, 0x00, // linestring instead of point
+		0x01, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+	}
+
+	errs := []struct {
+		b        []byte
+		expected DecodeError
+		msg      string
+	}{
+		{
+			rawMultiPolygon[:len(rawMultiPolygon)-10],
+			DecodeError{len(rawMultiPolygon) - 16, GeomPolygon, GeomPolygon, "MultiPolygon[1].ring[0].point[4]", ErrInvalidStorage},
+			"Invalid storage type or size at offset 163 in MultiPolygon[1].ring[0].point[4]",
+		},
+		{
+			mismatch,
+			DecodeError{9, GeomPoint, GeomLineString, "MultiPoint[0]", ErrUnsupportedValue},
+			"Unsupported value at offset 9 in MultiPoint[0]: expected Point, got LineString",
+		},
+		{
+			[]byte{0x01, 0x0d, 0x00, 0x00, 0x00},
+			DecodeError{0, 0, GeomCurve, "", ErrUnsupportedValue},
+			"Unsupported value at offset 0: got Curve",
+		},
+	}
+
+	for _, e := range errs {
+		_, err := New(e.b)
+		if assert.ErrorIs(t, err, e.expected.Err) {
+			assert.Equal(t, &e.expected, err)
+			assert.EqualError(t, err, e.msg)
+		}
+	}
+
+	_, err := NewDecoder(bytes.NewReader(rawMultiPolygon[:len(rawMultiPolygon)-10])).Decode()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Equal(t, &DecodeError{len(rawMultiPolygon) - 16, GeomPolygon, GeomPolygon, "MultiPolygon[1].ring[0].point[4]", io.ErrUnexpectedEOF}, err)
+
+	_, err = NewDecoder(bytes.NewReader(mismatch)).Decode()
+	assert.Equal(t, &DecodeError{9, GeomPoint, GeomLineString, "MultiPoint[0]", ErrUnsupportedValue}, err)
+}
+
+func TestUnmarshalTo(t *testing.T) {
+	ls := make(LineString, 0, 8)
+	if assert.NoError(t, UnmarshalTo(rawLineString, &ls)) {
+		assert.Equal(t, LineString{{30, 10}, {10, 30}, {40, 40}}, ls)
+		assert.Equal(t, 8, cap(ls))
+	}
+
+	mp := MultiPolygon{}
+	assert.NoError(t, mp.Scan(rawMultiPolygon))
+	expected, ring := MultiPolygon{}, &mp[0][0][0]
+	assert.NoError(t, expected.Scan(rawMultiPolygon))
+	if assert.NoError(t, mp.Scan(rawMultiPolygon)) {
+		assert.Equal(t, expected, mp)
+		assert.Same(t, ring, &mp[0][0][0])
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := mp.Scan(rawMultiPolygon); err != nil {
+			t.Fatal(err)
+		}
+	})
+	assert.Zero(t, allocs)
+
+	// receiver is left unchanged on error
+	if err := ls.Scan(rawPoint); assert.Error(t, err) {
+		assert.Equal(t, LineString{{30, 10}, {10, 30}, {40, 40}}, ls)
+	}
+
+	// including elements decoded before failing one
+	if err := mp.Scan(rawMultiPolygon[:len(rawMultiPolygon)-1]); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+		assert.Equal(t, expected, mp)
+	}
+	if err := UnmarshalTo(rawMultiPolygon, &mp, WithLimits(Limits{MaxElements: 4})); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+		assert.Equal(t, expected, mp)
+	}
+
+	tin := TIN{{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}, {{{1, 1}, {2, 1}, {1, 2}, {1, 1}}}}
+	if err := tin.Scan(Marshal(TIN{{{{5, 5}, {6, 5}, {5, 6}, {5, 5}}}, {{{0, 0}, {1, 0}, {0, 1}, {1, 1}}}})); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+		assert.Equal(t, TIN{{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}, {{{1, 1}, {2, 1}, {1, 2}, {1, 1}}}}, tin)
+	}
+
+	gc := GeometryCollection{}
+	if err := UnmarshalTo(nestedCollection(3, LittleEndian), &gc, WithLimits(Limits{MaxDepth: 3})); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrLimitExceeded)
+	}
+
+	e := EWKB{}
+	if assert.NoError(t, UnmarshalTo(rawEWKBPoint, &e)) {
+		assert.Equal(t, EWKB{4326, Point{30, 10}}, e)
+	}
+}
+
+func TestDecodeErrorDeepPath(t *testing.T) {
+	deep := nestedCollection(12, LittleEndian)
+	_, err := New(deep[:len(deep)-1])
+	var de *DecodeError
+	if assert.ErrorAs(t, err, &de) {
+		assert.Equal(t, "GeometryCollection"+strings.Repeat("[0]", 12), de.Path)
+		assert.Equal(t, len(deep)-CountSize, de.Offset)
+	}
+}
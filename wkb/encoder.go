@@ -0,0 +1,97 @@
+package wkb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+)
+
+type encoder struct {
+	buf   []byte
+	flag  byte
+	order appendByteOrder
+}
+
+type appendByteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+// EncoderOption configures encoding of geometries.
+type EncoderOption func(*encoder)
+
+// WithByteOrder sets byte order of encoded geometries, little-endian (NDR) is used by default.
+func WithByteOrder(order ByteOrder) EncoderOption {
+	return func(e *encoder) {
+		if enc, ok := byteOrder(byte(order)).(appendByteOrder); ok {
+			e.flag, e.order = byte(order), enc
+		}
+	}
+}
+
+func newEncoder(dst []byte, opts ...EncoderOption) *encoder {
+	e := &encoder{buf: dst, flag: LittleEndian, order: binary.LittleEndian}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+var encoders = sync.Pool{
+	New: func() interface{} {
+		return &encoder{}
+	},
+}
+
+// appendEncoder returns pooled encoder with default options appending to dst.
+func appendEncoder(dst []byte) *encoder {
+	e := encoders.Get().(*encoder)
+	e.buf, e.flag, e.order = dst, LittleEndian, binary.LittleEndian
+	return e
+}
+
+// release returns encoded bytes and puts encoder back to pool.
+func (e *encoder) release() []byte {
+	b := e.buf
+	e.buf = nil
+	encoders.Put(e)
+	return b
+}
+
+type encodable interface {
+	encode(e *encoder)
+}
+
+func (e *encoder) geometry(g Geometry) {
+	if enc, ok := g.(encodable); ok {
+		enc.encode(e)
+		return
+	}
+
+	buf := bytes.NewBuffer(e.buf)
+	g.Write(buf)
+	e.buf = buf.Bytes()
+}
+
+func (e *encoder) members(k Kind, gs []Geometry) {
+	e.header(k)
+	e.count(len(gs))
+	for _, g := range gs {
+		e.geometry(g)
+	}
+}
+
+func membersSize(gs []Geometry) int {
+	size := HeaderSize + CountSize
+	for _, g := range gs {
+		size += g.ByteSize()
+	}
+	return size
+}
+
+// Marshal encodes geometry as WKB.
+func Marshal(g Geometry, opts ...EncoderOption) []byte {
+	e := newEncoder(make([]byte, 0, g.ByteSize()), opts...)
+	e.geometry(g)
+	return e.buf
+}
@@ -0,0 +1,150 @@
+package wkb
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawBigEndianPoint = []byte{
+		0x00, 0x00, 0x00, 0x00, 0x01, // header - point, big endian
+		0x40, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x40, 0x24, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	rawBigEndianMultiPoint = []byte{
+		0x00, 0x00, 0x00, 0x00, 0x04, // header - multipoint, big endian
+		0x00, 0x00, 0x00, 0x02, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x01, // point
+		0x40, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x40, 0x24, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x01, // point
+		0x40, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x40, 0x34, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+)
+
+func TestMarshal(t *testing.T) {
+	p := Point{30, 10}
+	assert.Equal(t, rawPoint, Marshal(p))
+	assert.Equal(t, rawPoint, Marshal(p, WithByteOrder(LittleEndian)))
+	assert.Equal(t, rawBigEndianPoint, Marshal(p, WithByteOrder(BigEndian)))
+	assert.Equal(t, rawBigEndianMultiPoint, Marshal(MultiPoint{{30, 10}, {5, 20}}, WithByteOrder(BigEndian)))
+
+	// unknown byte order falls back to default
+	assert.Equal(t, rawPoint, Marshal(p, WithByteOrder(2)))
+
+	ewkb := EWKB{SRID: 3948, Geometry: MultiPointM{{30, 10, 5}}}
+	b := Marshal(ewkb, WithByteOrder(BigEndian))
+	assert.Equal(t, rawEWKBMultiPointM[:HeaderSize+SRIDSize], b[:HeaderSize+SRIDSize])
+	_, actual, err := ReadEWKB(b)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ewkb, actual)
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	geoms := []Geometry{
+		Point{30, 10},
+		LineStringZ{{30, 10, 1}, {10, 30, 2}},
+		Polygon{{{30, 10}, {40, 40}, {20, 40}, {30, 10}}},
+		MultiPolygonZM{{{{30, 10, 1, 2}, {40, 40, 3, 4}, {30, 10, 1, 2}}}},
+		GeometryCollection{Point{4, 6}, LineString{{4, 6}, {7, 10}}},
+	}
+
+	for _, g := range geoms {
+		b := Marshal(g, WithByteOrder(BigEndian))
+		assert.Equal(t, byte(BigEndian), b[0])
+		assert.Equal(t, g.ByteSize(), len(b))
+
+		_, actual, err := ReadGeometry(b)
+		if assert.NoError(t, err) {
+			assert.Equal(t, g, actual)
+		}
+	}
+
+	for _, g := range []Geometry{Spatialite{SRID: 4326, Geometry: geoms[2]}, GeoPackage{SRID: 4326, Geometry: geoms[2]}} {
+		b := Marshal(g, WithByteOrder(BigEndian))
+		assert.Equal(t, g.ByteSize(), len(b))
+
+		var err error
+		var actual Geometry
+		switch g.(type) {
+		case Spatialite:
+			_, actual, err = ReadSpatialite(b)
+		case GeoPackage:
+			_, actual, err = ReadGeoPackage(b)
+		}
+		if assert.NoError(t, err) {
+			assert.Equal(t, g, actual)
+		}
+	}
+}
+
+func TestAppendWKB(t *testing.T) {
+	prefix := []byte{0xff, 0xfe}
+	b := Point{30, 10}.AppendWKB(prefix)
+	assert.Equal(t, append([]byte{0xff, 0xfe}, rawPoint...), b)
+
+	mp := MultiPoint{{30, 10}, {5, 20}}
+	b = mp.AppendWKB(b[:0])
+	assert.Equal(t, Marshal(mp), b)
+
+	gp := GeoPackage{4326, LineString{{10, 10}, {40, 40}}}
+	assert.Equal(t, rawGeoPackageLineString, gp.AppendWKB(nil))
+	assert.Equal(t, rawEWKBPoint, EWKB{4326, Point{30, 10}}.AppendWKB(nil))
+	assert.Equal(t, rawLineString, Geom{LineString{{30, 10}, {10, 30}, {40, 40}}}.AppendWKB(nil))
+}
+
+func TestAppendWKBAllocs(t *testing.T) {
+	geoms := []interface {
+		Geometry
+		AppendWKB([]byte) []byte
+	}{
+		Point{30, 10},
+		LineString{{30, 10}, {10, 30}, {40, 40}},
+		MultiPolygonZ{{{{30, 10, 1}, {40, 40, 2}, {30, 10, 1}}}},
+		GeometryCollection{Point{4, 6}, LineString{{4, 6}, {7, 10}}},
+		CurvePolygon{CircularString{{0, 0}, {2, 0}, {0, 0}}},
+	}
+
+	buf := make([]byte, 0, 1024)
+	for _, g := range geoms {
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = g.AppendWKB(buf[:0])
+		})
+		assert.Zero(t, allocs, "%T", g)
+		assert.Equal(t, Marshal(g), buf)
+	}
+}
+
+func TestBinaryMarshaler(t *testing.T) {
+	var (
+		_ encoding.BinaryMarshaler   = Point{}
+		_ encoding.BinaryUnmarshaler = &Point{}
+		_ encoding.BinaryMarshaler   = GeoPackage{}
+		_ encoding.BinaryUnmarshaler = &Geom{}
+	)
+
+	ls := LineStringZ{{30, 10, 1}, {10, 30, 2}}
+	b, err := ls.MarshalBinary()
+	if assert.NoError(t, err) {
+		actual := LineStringZ{}
+		assert.NoError(t, actual.UnmarshalBinary(b))
+		assert.Equal(t, ls, actual)
+	}
+
+	g := Geom{}
+	if assert.NoError(t, g.UnmarshalBinary(rawPoint)) {
+		assert.Equal(t, Point{30, 10}, g.Geometry)
+	}
+
+	if _, err := (Geom{}).MarshalBinary(); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	if err := (&Point{}).UnmarshalBinary(rawLineString); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+}
@@ -0,0 +1,73 @@
+package wkb
+
+import (
+	"math"
+)
+
+// Envelope is bounding box of geometry in X and Y, empty when geometry has no coordinates.
+type Envelope struct {
+	MinX, MinY, MaxX, MaxY float64
+}
+
+// EmptyEnvelope returns envelope containing no coordinates.
+func EmptyEnvelope() Envelope {
+	return Envelope{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+}
+
+func (e Envelope) IsEmpty() bool {
+	return e.MinX > e.MaxX || e.MinY > e.MaxY
+}
+
+// add returns envelope extended by coordinate, skipping NaN coordinates of empty points.
+func (e Envelope) add(x, y float64) Envelope {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return e
+	}
+	return Envelope{math.Min(e.MinX, x), math.Min(e.MinY, y), math.Max(e.MaxX, x), math.Max(e.MaxY, y)}
+}
+
+// Intersects reports whether envelopes have any point in common, touching boundaries included.
+func (e Envelope) Intersects(other Envelope) bool {
+	return e.MinX <= other.MaxX && other.MinX <= e.MaxX &&
+		e.MinY <= other.MaxY && other.MinY <= e.MaxY
+}
+
+// Contains reports whether other lies within envelope, boundary included. Empty envelope contains nothing.
+func (e Envelope) Contains(other Envelope) bool {
+	return !other.IsEmpty() &&
+		e.MinX <= other.MinX && other.MaxX <= e.MaxX &&
+		e.MinY <= other.MinY && other.MaxY <= e.MaxY
+}
+
+// Expand returns envelope grown by distance d on every side, like ST_Expand.
+// Negative distance shrinks it, empty envelope stays empty.
+func (e Envelope) Expand(d float64) Envelope {
+	if e.IsEmpty() {
+		return e
+	}
+	return Envelope{e.MinX - d, e.MinY - d, e.MaxX + d, e.MaxY + d}
+}
+
+// Union returns smallest envelope covering both envelopes.
+func (e Envelope) Union(other Envelope) Envelope {
+	return Envelope{
+		math.Min(e.MinX, other.MinX), math.Min(e.MinY, other.MinY),
+		math.Max(e.MaxX, other.MaxX), math.Max(e.MaxY, other.MaxY),
+	}
+}
+
+func envelope[T coord](pts []T) Envelope {
+	e := EmptyEnvelope()
+	for _, p := range pts {
+		e = e.add(p.xy())
+	}
+	return e
+}
+
+func envelopeAll[T Geometry](gs []T) Envelope {
+	e := EmptyEnvelope()
+	for _, g := range gs {
+		e = e.Union(g.Envelope())
+	}
+	return e
+}
@@ -0,0 +1,44 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvelope(t *testing.T) {
+	e := Envelope{0, 0, 10, 10}
+	empty := EmptyEnvelope()
+
+	assert.True(t, empty.IsEmpty())
+	assert.False(t, e.IsEmpty())
+	assert.False(t, Envelope{}.IsEmpty())
+
+	assert.True(t, e.Intersects(Envelope{5, 5, 15, 15}))
+	assert.True(t, e.Intersects(Envelope{10, 10, 20, 20}))
+	assert.True(t, e.Intersects(Envelope{2, 2, 3, 3}))
+	assert.False(t, e.Intersects(Envelope{11, 0, 20, 10}))
+	assert.False(t, e.Intersects(empty))
+	assert.False(t, empty.Intersects(e))
+
+	assert.True(t, e.Contains(Envelope{2, 2, 3, 3}))
+	assert.True(t, e.Contains(e))
+	assert.False(t, e.Contains(Envelope{5, 5, 15, 15}))
+	assert.False(t, e.Contains(empty))
+	assert.False(t, empty.Contains(e))
+
+	assert.Equal(t, Envelope{-1, -1, 11, 11}, e.Expand(1))
+	assert.True(t, e.Expand(-6).IsEmpty())
+	assert.True(t, empty.Expand(1).IsEmpty())
+
+	assert.Equal(t, Envelope{0, -5, 10, 10}, e.Union(Envelope{1, -5, 2, 0}))
+	assert.Equal(t, e, e.Union(empty))
+	assert.Equal(t, e, empty.Union(e))
+
+	// pre-filter geometries by envelope of query window
+	window := Point{1, 1}.Envelope().Expand(2)
+	assert.True(t, window.Intersects(LineString{{3, 3}, {4, 4}}.Envelope()))
+	assert.False(t, window.Intersects(Polygon{{{4, 4}, {5, 4}, {5, 5}, {4, 4}}}.Envelope()))
+	assert.True(t, window.Contains(MultiPoint{{0, 0}, {2, 2}}.Envelope()))
+	assert.False(t, window.Contains(GeometryCollection{Point{0, 0}, LineString{{0, 0}, {4, 0}}}.Envelope()))
+}
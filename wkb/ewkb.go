@@ -0,0 +1,123 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// EWKB is a geometry in PostGIS extended WKB format with optional SRID.
+// Zero SRID is treated as unknown and omitted on write.
+type EWKB struct {
+	SRID int
+	Geometry
+}
+
+func (e *EWKB) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalBinary(b)
+}
+
+func (e EWKB) Value() (driver.Value, error) {
+	return e.MarshalBinary()
+}
+
+func (e EWKB) MarshalBinary() ([]byte, error) {
+	if e.Geometry == nil {
+		return nil, ErrUnsupportedValue
+	}
+	return e.AppendWKB(make([]byte, 0, e.ByteSize())), nil
+}
+
+func (e *EWKB) UnmarshalBinary(b []byte) error {
+	_, tmp, err := ReadEWKB(b)
+	if err != nil {
+		return err
+	}
+
+	*e = tmp
+	return nil
+}
+
+func ReadEWKB(b []byte) ([]byte, EWKB, error) {
+	e := EWKB{}
+	if len(b) < HeaderSize {
+		return nil, e, ErrInvalidStorage
+	}
+
+	dec := byteOrder(b[0])
+	if dec == nil {
+		return nil, e, ErrInvalidStorage
+	}
+
+	_, code := readUint32(b[ByteOrderSize:], dec)
+	if code&ewkbSRID != 0 {
+		if len(b) < HeaderSize+SRIDSize {
+			return nil, e, ErrInvalidStorage
+		}
+
+		_, srid := readUint32(b[HeaderSize:], dec)
+		e.SRID = int(int32(srid))
+	}
+
+	b, g, err := ReadGeometry(b)
+	if err != nil {
+		return nil, EWKB{}, err
+	}
+
+	e.Geometry = g
+	return b, e, nil
+}
+
+func (e EWKB) ByteSize() int {
+	size := e.Geometry.ByteSize()
+	if e.SRID != 0 {
+		size += SRIDSize
+	}
+	return size
+}
+
+// Equal compares geometry with other, which must have same SRID when it is EWKB too.
+func (e EWKB) Equal(other Geometry) bool {
+	if o, ok := other.(EWKB); ok {
+		if e.SRID != o.SRID {
+			return false
+		}
+		other = o.Geometry
+	}
+	return e.Geometry.Equal(other)
+}
+
+// Write encodes geometry and replaces its header with EWKB type code and SRID.
+func (e EWKB) Write(buf *bytes.Buffer) {
+	buf.Write(e.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (e EWKB) AppendWKB(dst []byte) []byte {
+	if e.Geometry == nil {
+		return dst
+	}
+	w := appendEncoder(dst)
+	e.encode(w)
+	return w.release()
+}
+
+func (e EWKB) encode(w *encoder) {
+	start := len(w.buf)
+	w.geometry(e.Geometry)
+	if e.SRID != 0 {
+		w.buf = append(w.buf, make([]byte, SRIDSize)...)
+	}
+
+	b := w.buf[start:]
+	enc := byteOrder(b[0])
+	code := ewkbCode(kind(enc.Uint32(b[ByteOrderSize:])))
+	if e.SRID != 0 {
+		copy(b[HeaderSize+SRIDSize:], b[HeaderSize:len(b)-SRIDSize])
+		enc.PutUint32(b[HeaderSize:], uint32(int32(e.SRID)))
+		code |= ewkbSRID
+	}
+	enc.PutUint32(b[ByteOrderSize:], code)
+}
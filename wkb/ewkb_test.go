@@ -0,0 +1,124 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawEWKBPoint = []byte{
+		0x01, 0x01, 0x00, 0x00, 0x20, // header - point with srid
+		0xe6, 0x10, 0x00, 0x00, // srid - 4326
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+	}
+	rawEWKBPointZ = []byte{
+		0x01, 0x01, 0x00, 0x00, 0x80, // header - point z
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawEWKBMultiPointM = []byte{
+		0x00, 0x60, 0x00, 0x00, 0x04, // header - multipoint m with srid, big endian
+		0x00, 0x00, 0x0f, 0x6c, // srid - 3948
+		0x00, 0x00, 0x00, 0x01, // numpoints - 1
+		0x00, 0x40, 0x00, 0x00, 0x01, // point m
+		0x40, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x40, 0x24, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x40, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+)
+
+func TestEWKB(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// header too short
+			ErrInvalidStorage,
+			[]byte{0x01, 0x01},
+		},
+		{
+			// invalid byte order
+			ErrInvalidStorage,
+			[]byte{0x02, 0x01, 0x00, 0x00, 0x20},
+		},
+		{
+			// srid too short
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0x01, 0x00, 0x00, 0x20,
+				0xe6, 0x10,
+			},
+		},
+		{
+			// no payload
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0x01, 0x00, 0x00, 0x20,
+				0xe6, 0x10, 0x00, 0x00,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&EWKB{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	if err := (&EWKB{}).Scan(""); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+	}
+
+	e := EWKB{}
+	if assert.NoError(t, e.Scan(rawEWKBPoint)) {
+		assert.Equal(t, EWKB{4326, Point{30, 10}}, e)
+	}
+
+	if raw, err := e.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawEWKBPoint, raw)
+		assert.Len(t, raw, e.ByteSize())
+	}
+
+	e = EWKB{}
+	if assert.NoError(t, e.Scan(rawEWKBPointZ)) {
+		assert.Equal(t, EWKB{0, PointZ{30, 10, 5}}, e)
+	}
+
+	if raw, err := e.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawEWKBPointZ, raw)
+	}
+
+	e = EWKB{}
+	if assert.NoError(t, e.Scan(rawEWKBMultiPointM)) {
+		assert.Equal(t, EWKB{3948, MultiPointM{{30, 10, 5}}}, e)
+	}
+
+	if raw, err := (EWKB{3948, MultiPointM{{30, 10, 5}}}).Value(); assert.NoError(t, err) {
+		if _, tmp, err := ReadEWKB(raw.([]byte)); assert.NoError(t, err) {
+			assert.Equal(t, e, tmp)
+		}
+	}
+
+	_, err := EWKB{}.Value()
+	assert.ErrorIs(t, err, ErrUnsupportedValue)
+	assert.Empty(t, EWKB{}.AppendWKB(nil))
+}
+
+func TestEWKBCompatibility(t *testing.T) {
+	p := Point{}
+	if assert.NoError(t, p.Scan(rawEWKBPoint)) {
+		assert.Equal(t, Point{30, 10}, p)
+	}
+
+	if g, err := New(rawEWKBPointZ); assert.NoError(t, err) {
+		assert.Equal(t, PointZ{30, 10, 5}, g)
+	}
+
+	if err := (&Point{}).Scan(rawEWKBPointZ); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+}
@@ -0,0 +1,104 @@
+package wkb
+
+import (
+	"bytes"
+	"testing"
+)
+
+func fuzzSeeds() [][]byte {
+	return [][]byte{
+		rawPoint, rawLineString, rawPolygon, rawMultiPoint, rawMultiLineString, rawMultiPolygon,
+		rawPointZ, rawLineStringZ, rawPolygonZ, rawMultiPointZ, rawMultiLineStringZ, rawMultiPolygonZ,
+		rawPointM, rawLineStringM, rawPolygonM, rawMultiPointM, rawMultiLineStringM, rawMultiPolygonM,
+		rawPointZM, rawLineStringZM, rawPolygonZM, rawMultiPointZM, rawMultiLineStringZM, rawMultiPolygonZM,
+		rawEWKBPoint, rawEWKBPointZ, rawEWKBMultiPointM, rawBigEndianPoint, rawBigEndianMultiPoint,
+		nestedCollection(4, LittleEndian),
+	}
+}
+
+func FuzzUnmarshal(f *testing.F) {
+	for _, b := range fuzzSeeds() {
+		f.Add(b)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		g, err := Unmarshal(b, WithLimits(Limits{MaxBytes: 1 << 16, MaxElements: 1 << 12, MaxDepth: 8}))
+		if err != nil {
+			return
+		}
+
+		buf := &bytes.Buffer{}
+		g.Write(buf)
+		if buf.Len() != g.ByteSize() {
+			t.Fatalf("encoded size %d, expected %d", buf.Len(), g.ByteSize())
+		}
+		if _, err := New(buf.Bytes()); err != nil {
+			t.Fatalf("decode of encoded geometry failed: %v", err)
+		}
+	})
+}
+
+func FuzzDecoder(f *testing.F) {
+	for _, b := range fuzzSeeds() {
+		f.Add(b)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		dec := NewDecoder(bytes.NewReader(b), WithLimits(Limits{MaxBytes: 1 << 16, MaxElements: 1 << 12, MaxDepth: 8}))
+		for {
+			if _, err := dec.Decode(); err != nil {
+				return
+			}
+		}
+	})
+}
+
+func FuzzReadSpatialite(f *testing.F) {
+	f.Add(rawSpatialitePoint)
+	f.Add(rawSpatialiteMultiPointZ)
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		ReadSpatialite(b)
+	})
+}
+
+func FuzzReadGeoPackage(f *testing.F) {
+	f.Add(rawGeoPackagePoint)
+	f.Add(rawGeoPackageLineString)
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		ReadGeoPackage(b)
+	})
+}
+
+func FuzzView(f *testing.F) {
+	for _, b := range fuzzSeeds() {
+		f.Add(b)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		v, err := NewView(b)
+		if err != nil {
+			return
+		}
+
+		var visit func(v View)
+		visit = func(v View) {
+			v.Kind()
+			v.NumPoints()
+			v.Envelope()
+			for i := 0; i < v.Coords().Len(); i++ {
+				v.Coords().XY(i)
+			}
+			for i := 0; i < v.NumRings(); i++ {
+				for j := 0; j < v.Ring(i).Len(); j++ {
+					v.Ring(i).Ordinates(nil, j)
+				}
+			}
+			for _, g := range v.Geometries() {
+				visit(g)
+			}
+		}
+		visit(v)
+	})
+}
@@ -0,0 +1,899 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+)
+
+var ErrInvalidGeoJSON = errors.New("Invalid GeoJSON")
+
+var geojsonTypes = map[Kind]string{
+	GeomPoint:           "Point",
+	GeomLineString:      "LineString",
+	GeomPolygon:         "Polygon",
+	GeomMultiPoint:      "MultiPoint",
+	GeomMultiLineString: "MultiLineString",
+	GeomMultiPolygon:    "MultiPolygon",
+	GeomCollection:      "GeometryCollection",
+}
+
+// Feature is GeoJSON feature with geometry and arbitrary properties.
+type Feature struct {
+	ID         interface{}
+	Geometry   Geometry
+	Properties map[string]interface{}
+}
+
+type FeatureCollection []Feature
+
+type geojsonFeature struct {
+	Type       string                 `json:"type"`
+	ID         interface{}            `json:"id,omitempty"`
+	Geometry   json.RawMessage        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geojsonFeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+type geojsonGeometry struct {
+	Type        string            `json:"type"`
+	Coordinates json.RawMessage   `json:"coordinates"`
+	Geometries  []json.RawMessage `json:"geometries"`
+}
+
+// ParseGeoJSON parses RFC 7946 geometry object.
+// Dimension is inferred from number of coordinate ordinates, with 3 meaning Z and 4 meaning ZM.
+func ParseGeoJSON(data []byte) (Geometry, error) {
+	e := newEncoder(nil)
+	if _, err := geojsonToWKB(e, data, -1); err != nil {
+		return nil, err
+	}
+
+	_, g, err := ReadGeometry(e.buf)
+	return g, err
+}
+
+func (f Feature) MarshalJSON() ([]byte, error) {
+	geom, err := marshalGeoJSON(f.Geometry)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(geojsonFeature{"Feature", f.ID, geom, f.Properties})
+}
+
+func (f *Feature) UnmarshalJSON(data []byte) error {
+	tmp := geojsonFeature{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	if tmp.Type != "Feature" {
+		return ErrInvalidGeoJSON
+	}
+
+	var g Geometry
+	if len(tmp.Geometry) > 0 {
+		var err error
+		if g, err = parseGeoJSON(tmp.Geometry); err != nil {
+			return err
+		}
+	}
+
+	*f = Feature{tmp.ID, g, tmp.Properties}
+	return nil
+}
+
+func (fc FeatureCollection) MarshalJSON() ([]byte, error) {
+	if fc == nil {
+		fc = FeatureCollection{}
+	}
+	return json.Marshal(geojsonFeatureCollection{"FeatureCollection", fc})
+}
+
+func (fc *FeatureCollection) UnmarshalJSON(data []byte) error {
+	tmp := geojsonFeatureCollection{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	if tmp.Type != "FeatureCollection" {
+		return ErrInvalidGeoJSON
+	}
+
+	*fc = tmp.Features
+	return nil
+}
+
+// geojsonToWKB converts GeoJSON geometry object to WKB using given dimension, or inferring it when negative.
+// It returns dimension of converted geometry.
+func geojsonToWKB(e *encoder, data []byte, dim int) (int, error) {
+	obj := geojsonGeometry{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return 0, err
+	}
+
+	var k Kind
+	for tk, t := range geojsonTypes {
+		if t == obj.Type {
+			k = tk
+		}
+	}
+	if k == 0 {
+		return 0, ErrUnsupportedValue
+	}
+
+	start := len(e.buf)
+	e.header(k)
+
+	var err error
+	switch k {
+	case GeomPoint:
+		c := []float64{}
+		if err = geojsonCoords(obj.Coordinates, &c); err == nil {
+			dim, err = geojsonPoint(e, c, dim)
+		}
+	case GeomLineString:
+		c := [][]float64{}
+		if err = geojsonCoords(obj.Coordinates, &c); err == nil {
+			dim, err = geojsonPoints(e, c, dim)
+		}
+	case GeomPolygon:
+		c := [][][]float64{}
+		if err = geojsonCoords(obj.Coordinates, &c); err == nil {
+			e.count(len(c))
+			for _, r := range c {
+				if dim, err = geojsonPoints(e, r, dim); err != nil {
+					break
+				}
+			}
+		}
+	case GeomMultiPoint:
+		c := [][]float64{}
+		if err = geojsonCoords(obj.Coordinates, &c); err == nil {
+			e.count(len(c))
+			for _, p := range c {
+				if dim, err = geojsonElement(e, GeomPoint, dim, func(dim int) (int, error) {
+					return geojsonPoint(e, p, dim)
+				}); err != nil {
+					break
+				}
+			}
+		}
+	case GeomMultiLineString:
+		c := [][][]float64{}
+		if err = geojsonCoords(obj.Coordinates, &c); err == nil {
+			e.count(len(c))
+			for _, ls := range c {
+				if dim, err = geojsonElement(e, GeomLineString, dim, func(dim int) (int, error) {
+					return geojsonPoints(e, ls, dim)
+				}); err != nil {
+					break
+				}
+			}
+		}
+	case GeomMultiPolygon:
+		c := [][][][]float64{}
+		if err = geojsonCoords(obj.Coordinates, &c); err == nil {
+			e.count(len(c))
+			for _, p := range c {
+				if dim, err = geojsonElement(e, GeomPolygon, dim, func(dim int) (int, error) {
+					var err error
+					e.count(len(p))
+					for _, r := range p {
+						if dim, err = geojsonPoints(e, r, dim); err != nil {
+							break
+						}
+					}
+					return dim, err
+				}); err != nil {
+					break
+				}
+			}
+		}
+	case GeomCollection:
+		e.count(len(obj.Geometries))
+		for _, g := range obj.Geometries {
+			var d int
+			if d, err = geojsonToWKB(e, g, dim); err != nil {
+				break
+			}
+			if dim < 0 {
+				dim = d
+			}
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if dim < 0 {
+		dim = 0
+	}
+	e.order.PutUint32(e.buf[start+ByteOrderSize:], uint32(k)+uint32(dim)*1000)
+	return dim, nil
+}
+
+func geojsonCoords(data json.RawMessage, dst interface{}) error {
+	if len(data) == 0 {
+		return ErrInvalidGeoJSON
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		return ErrInvalidGeoJSON
+	}
+	return nil
+}
+
+// geojsonElement writes nested geometry of multi geometry, patching its type code once dimension is known.
+func geojsonElement(e *encoder, k Kind, dim int, body func(dim int) (int, error)) (int, error) {
+	off := len(e.buf)
+	e.header(k)
+
+	dim, err := body(dim)
+	if err != nil {
+		return 0, err
+	}
+
+	d := dim
+	if d < 0 {
+		d = 0
+	}
+	e.order.PutUint32(e.buf[off+ByteOrderSize:], uint32(k)+uint32(d)*1000)
+	return dim, nil
+}
+
+func geojsonPoint(e *encoder, c []float64, dim int) (int, error) {
+	if len(c) == 0 {
+		if dim < 0 {
+			dim = 0
+		}
+		for i := 0; i < dimSize(dim); i++ {
+			e.float64(math.NaN())
+		}
+		return dim, nil
+	}
+
+	if dim < 0 {
+		switch len(c) {
+		case 2:
+			dim = 0
+		case 3:
+			dim = 1
+		case 4:
+			dim = 3
+		default:
+			return 0, ErrInvalidGeoJSON
+		}
+	}
+
+	if dim >= 2 && len(c) == dimSize(dim)-1 {
+		// M is not written to GeoJSON, so it is zero when missing
+		c = append(c, 0)
+	}
+
+	if len(c) != dimSize(dim) {
+		return 0, ErrInvalidGeoJSON
+	}
+
+	for _, f := range c {
+		e.float64(f)
+	}
+	return dim, nil
+}
+
+func geojsonPoints(e *encoder, c [][]float64, dim int) (int, error) {
+	e.count(len(c))
+	for _, p := range c {
+		if len(p) == 0 {
+			return 0, ErrInvalidGeoJSON
+		}
+
+		var err error
+		if dim, err = geojsonPoint(e, p, dim); err != nil {
+			return 0, err
+		}
+	}
+	return dim, nil
+}
+
+// geojson formats geometry by encoding it to WKB and rendering the encoded form.
+func geojson(g Geometry) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, g.ByteSize()))
+	g.Write(buf)
+
+	out := &bytes.Buffer{}
+	if _, err := formatGeoJSON(out, buf.Bytes(), true); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func formatGeoJSON(out *bytes.Buffer, b []byte, tagged bool) ([]byte, error) {
+	if len(b) < HeaderSize {
+		return nil, ErrInvalidStorage
+	}
+
+	dec := byteOrder(b[0])
+	if dec == nil {
+		return nil, ErrInvalidStorage
+	}
+
+	b, code := readUint32(b[ByteOrderSize:], dec)
+	k := kind(code)
+	size, ok := coordSize(k)
+	tpe, known := geojsonTypes[k%1000]
+	if !ok || !known {
+		return nil, ErrUnsupportedValue
+	}
+
+	if tagged {
+		out.WriteString(`{"type":"`)
+		out.WriteString(tpe)
+		if k%1000 == GeomCollection {
+			out.WriteString(`","geometries":`)
+		} else {
+			out.WriteString(`","coordinates":`)
+		}
+	}
+
+	// position has no place for M, so it is dropped
+	ords := size / Float64Size
+	if k/1000 >= 2 {
+		ords--
+	}
+
+	coord := func(b []byte) ([]byte, error) {
+		scratch := [32]byte{}
+		out.WriteByte('[')
+		for i := 0; i < size/Float64Size; i++ {
+			var f float64
+			b, f = readFloat64(b, dec)
+			if i >= ords {
+				continue
+			}
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, ErrUnsupportedValue
+			}
+			out.Write(strconv.AppendFloat(scratch[:0], f, 'f', -1, 64))
+		}
+		out.WriteByte(']')
+		return b, nil
+	}
+
+	count := func(b []byte) ([]byte, int, error) {
+		if len(b) < CountSize {
+			return nil, 0, ErrInvalidStorage
+		}
+		b, n := readCount(b, dec)
+		return b, n, nil
+	}
+
+	coords := func(b []byte) ([]byte, error) {
+		b, n, err := count(b)
+		if err != nil {
+			return nil, err
+		}
+		if n > len(b)/size {
+			return nil, ErrInvalidStorage
+		}
+		out.WriteByte('[')
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			if b, err = coord(b); err != nil {
+				return nil, err
+			}
+		}
+		out.WriteByte(']')
+		return b, nil
+	}
+
+	var err error
+	switch k % 1000 {
+	case GeomPoint:
+		if len(b) < size {
+			return nil, ErrInvalidStorage
+		}
+		if isNaN(b[:size], dec) {
+			out.WriteString("[]")
+			b = b[size:]
+		} else if b, err = coord(b); err != nil {
+			return nil, err
+		}
+	case GeomLineString:
+		if b, err = coords(b); err != nil {
+			return nil, err
+		}
+	case GeomPolygon:
+		var n int
+		if b, n, err = count(b); err != nil {
+			return nil, err
+		}
+		out.WriteByte('[')
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			if b, err = coords(b); err != nil {
+				return nil, err
+			}
+		}
+		out.WriteByte(']')
+	default:
+		var n int
+		if b, n, err = count(b); err != nil {
+			return nil, err
+		}
+		out.WriteByte('[')
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				out.WriteByte(',')
+			}
+			if b, err = formatGeoJSON(out, b, k%1000 == GeomCollection); err != nil {
+				return nil, err
+			}
+		}
+		out.WriteByte(']')
+	}
+
+	if tagged {
+		out.WriteByte('}')
+	}
+	return b, nil
+}
+
+func unmarshalGeoJSON(data []byte, dst sql.Scanner, dim int) error {
+	e := newEncoder(nil)
+	if _, err := geojsonToWKB(e, data, dim); err != nil {
+		return err
+	}
+	return dst.Scan(e.buf)
+}
+
+// marshalGeoJSON formats geometry using its own MarshalJSON when it has one, nil geometry is null.
+func marshalGeoJSON(g Geometry) ([]byte, error) {
+	switch m := g.(type) {
+	case nil:
+		return []byte("null"), nil
+	case json.Marshaler:
+		return m.MarshalJSON()
+	}
+	return geojson(g)
+}
+
+// parseGeoJSON parses geometry object, null is nil geometry.
+func parseGeoJSON(data []byte) (Geometry, error) {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil, nil
+	}
+	return ParseGeoJSON(data)
+}
+
+func (g Geom) MarshalJSON() ([]byte, error) {
+	return marshalGeoJSON(g.Geometry)
+}
+
+func (g *Geom) UnmarshalJSON(data []byte) error {
+	tmp, err := parseGeoJSON(data)
+	if err != nil {
+		return err
+	}
+
+	g.Geometry = tmp
+	return nil
+}
+
+func (e EWKB) MarshalJSON() ([]byte, error) {
+	return marshalGeoJSON(e.Geometry)
+}
+
+func (e *EWKB) UnmarshalJSON(data []byte) error {
+	tmp, err := parseGeoJSON(data)
+	if err != nil {
+		return err
+	}
+
+	*e = EWKB{geojsonSRID(tmp), tmp}
+	return nil
+}
+
+func (s Spatialite) MarshalJSON() ([]byte, error) {
+	return marshalGeoJSON(s.Geometry)
+}
+
+func (s *Spatialite) UnmarshalJSON(data []byte) error {
+	tmp, err := parseGeoJSON(data)
+	if err != nil {
+		return err
+	}
+
+	*s = Spatialite{geojsonSRID(tmp), tmp}
+	return nil
+}
+
+func (gp GeoPackage) MarshalJSON() ([]byte, error) {
+	return marshalGeoJSON(gp.Geometry)
+}
+
+func (gp *GeoPackage) UnmarshalJSON(data []byte) error {
+	tmp, err := parseGeoJSON(data)
+	if err != nil {
+		return err
+	}
+
+	*gp = GeoPackage{geojsonSRID(tmp), tmp}
+	return nil
+}
+
+// geojsonSRID returns SRID of parsed geometry, GeoJSON coordinates are WGS84 by RFC 7946.
+func geojsonSRID(g Geometry) int {
+	if g == nil {
+		return 0
+	}
+	return 4326
+}
+
+func (p Point) MarshalJSON() ([]byte, error) {
+	return geojson(p)
+}
+
+func (p *Point) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, p, 0)
+}
+
+func (ls LineString) MarshalJSON() ([]byte, error) {
+	return geojson(ls)
+}
+
+func (ls *LineString) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, ls, 0)
+}
+
+func (p Polygon) MarshalJSON() ([]byte, error) {
+	return geojson(p)
+}
+
+func (p *Polygon) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, p, 0)
+}
+
+func (mp MultiPoint) MarshalJSON() ([]byte, error) {
+	return geojson(mp)
+}
+
+func (mp *MultiPoint) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mp, 0)
+}
+
+func (mls MultiLineString) MarshalJSON() ([]byte, error) {
+	return geojson(mls)
+}
+
+func (mls *MultiLineString) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mls, 0)
+}
+
+func (mp MultiPolygon) MarshalJSON() ([]byte, error) {
+	return geojson(mp)
+}
+
+func (mp *MultiPolygon) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mp, 0)
+}
+
+func (gc GeometryCollection) MarshalJSON() ([]byte, error) {
+	return geojson(gc)
+}
+
+func (gc *GeometryCollection) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, gc, 0)
+}
+
+func (p PointZ) MarshalJSON() ([]byte, error) {
+	return geojson(p)
+}
+
+func (p *PointZ) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, p, 1)
+}
+
+func (ls LineStringZ) MarshalJSON() ([]byte, error) {
+	return geojson(ls)
+}
+
+func (ls *LineStringZ) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, ls, 1)
+}
+
+func (p PolygonZ) MarshalJSON() ([]byte, error) {
+	return geojson(p)
+}
+
+func (p *PolygonZ) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, p, 1)
+}
+
+func (mp MultiPointZ) MarshalJSON() ([]byte, error) {
+	return geojson(mp)
+}
+
+func (mp *MultiPointZ) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mp, 1)
+}
+
+func (mls MultiLineStringZ) MarshalJSON() ([]byte, error) {
+	return geojson(mls)
+}
+
+func (mls *MultiLineStringZ) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mls, 1)
+}
+
+func (mp MultiPolygonZ) MarshalJSON() ([]byte, error) {
+	return geojson(mp)
+}
+
+func (mp *MultiPolygonZ) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mp, 1)
+}
+
+func (gc GeometryCollectionZ) MarshalJSON() ([]byte, error) {
+	return geojson(gc)
+}
+
+func (gc *GeometryCollectionZ) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, gc, 1)
+}
+
+func (p PointM) MarshalJSON() ([]byte, error) {
+	return geojson(p)
+}
+
+func (p *PointM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, p, 2)
+}
+
+func (ls LineStringM) MarshalJSON() ([]byte, error) {
+	return geojson(ls)
+}
+
+func (ls *LineStringM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, ls, 2)
+}
+
+func (p PolygonM) MarshalJSON() ([]byte, error) {
+	return geojson(p)
+}
+
+func (p *PolygonM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, p, 2)
+}
+
+func (mp MultiPointM) MarshalJSON() ([]byte, error) {
+	return geojson(mp)
+}
+
+func (mp *MultiPointM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mp, 2)
+}
+
+func (mls MultiLineStringM) MarshalJSON() ([]byte, error) {
+	return geojson(mls)
+}
+
+func (mls *MultiLineStringM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mls, 2)
+}
+
+func (mp MultiPolygonM) MarshalJSON() ([]byte, error) {
+	return geojson(mp)
+}
+
+func (mp *MultiPolygonM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mp, 2)
+}
+
+func (gc GeometryCollectionM) MarshalJSON() ([]byte, error) {
+	return geojson(gc)
+}
+
+func (gc *GeometryCollectionM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, gc, 2)
+}
+
+func (p PointZM) MarshalJSON() ([]byte, error) {
+	return geojson(p)
+}
+
+func (p *PointZM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, p, 3)
+}
+
+func (ls LineStringZM) MarshalJSON() ([]byte, error) {
+	return geojson(ls)
+}
+
+func (ls *LineStringZM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, ls, 3)
+}
+
+func (p PolygonZM) MarshalJSON() ([]byte, error) {
+	return geojson(p)
+}
+
+func (p *PolygonZM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, p, 3)
+}
+
+func (mp MultiPointZM) MarshalJSON() ([]byte, error) {
+	return geojson(mp)
+}
+
+func (mp *MultiPointZM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mp, 3)
+}
+
+func (mls MultiLineStringZM) MarshalJSON() ([]byte, error) {
+	return geojson(mls)
+}
+
+func (mls *MultiLineStringZM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mls, 3)
+}
+
+func (mp MultiPolygonZM) MarshalJSON() ([]byte, error) {
+	return geojson(mp)
+}
+
+func (mp *MultiPolygonZM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, mp, 3)
+}
+
+func (gc GeometryCollectionZM) MarshalJSON() ([]byte, error) {
+	return geojson(gc)
+}
+
+func (gc *GeometryCollectionZM) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSON(data, gc, 3)
+}
+
+// GeoJSON has no arcs, so curves are linearized with DefaultSegments.
+func (cs CircularString) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cs, DefaultSegments))
+}
+
+func (cc CompoundCurve) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cc, DefaultSegments))
+}
+
+func (cp CurvePolygon) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cp, DefaultSegments))
+}
+
+func (mc MultiCurve) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(mc, DefaultSegments))
+}
+
+func (ms MultiSurface) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(ms, DefaultSegments))
+}
+
+func (cs CircularStringZ) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cs, DefaultSegments))
+}
+
+func (cc CompoundCurveZ) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cc, DefaultSegments))
+}
+
+func (cp CurvePolygonZ) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cp, DefaultSegments))
+}
+
+func (mc MultiCurveZ) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(mc, DefaultSegments))
+}
+
+func (ms MultiSurfaceZ) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(ms, DefaultSegments))
+}
+
+func (cs CircularStringM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cs, DefaultSegments))
+}
+
+func (cc CompoundCurveM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cc, DefaultSegments))
+}
+
+func (cp CurvePolygonM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cp, DefaultSegments))
+}
+
+func (mc MultiCurveM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(mc, DefaultSegments))
+}
+
+func (ms MultiSurfaceM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(ms, DefaultSegments))
+}
+
+func (cs CircularStringZM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cs, DefaultSegments))
+}
+
+func (cc CompoundCurveZM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cc, DefaultSegments))
+}
+
+func (cp CurvePolygonZM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(cp, DefaultSegments))
+}
+
+func (mc MultiCurveZM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(mc, DefaultSegments))
+}
+
+func (ms MultiSurfaceZM) MarshalJSON() ([]byte, error) {
+	return geojson(Linearize(ms, DefaultSegments))
+}
+
+// GeoJSON has no polyhedral surfaces and triangles.
+func (ps PolyhedralSurface) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (tin TIN) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (t Triangle) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (ps PolyhedralSurfaceZ) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (tin TINZ) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (t TriangleZ) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (ps PolyhedralSurfaceM) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (tin TINM) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (t TriangleM) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (ps PolyhedralSurfaceZM) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (tin TINZM) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
+
+func (t TriangleZM) MarshalJSON() ([]byte, error) {
+	return nil, ErrUnsupportedValue
+}
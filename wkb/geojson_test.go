@@ -0,0 +1,243 @@
+package wkb
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoJSON(t *testing.T) {
+	valid := []struct {
+		json string
+		g    Geometry
+	}{
+		{`{"type":"Point","coordinates":[30,10]}`, Point{30, 10}},
+		{`{"type":"Point","coordinates":[30,10,5]}`, PointZ{30, 10, 5}},
+		{`{"type":"LineString","coordinates":[[30,10],[10,30],[40,40]]}`, LineString{{30, 10}, {10, 30}, {40, 40}}},
+		{`{"type":"LineString","coordinates":[]}`, LineString{}},
+		{
+			`{"type":"Polygon","coordinates":[[[35,10],[45,45],[15,40],[10,20],[35,10]],[[20,30],[35,35],[30,20],[20,30]]]}`,
+			Polygon{
+				LinearRing{{35, 10}, {45, 45}, {15, 40}, {10, 20}, {35, 10}},
+				LinearRing{{20, 30}, {35, 35}, {30, 20}, {20, 30}},
+			},
+		},
+		{`{"type":"MultiPoint","coordinates":[[10,40],[40,30]]}`, MultiPoint{{10, 40}, {40, 30}}},
+		{
+			`{"type":"MultiLineString","coordinates":[[[10,10],[20,20]],[[40,40],[30,30.5]]]}`,
+			MultiLineString{LineString{{10, 10}, {20, 20}}, LineString{{40, 40}, {30, 30.5}}},
+		},
+		{
+			`{"type":"MultiPolygon","coordinates":[[[[30,20,1],[45,40,1],[10,40,1],[30,20,1]]]]}`,
+			MultiPolygonZ{PolygonZ{LinearRingZ{{30, 20, 1}, {45, 40, 1}, {10, 40, 1}, {30, 20, 1}}}},
+		},
+		{
+			`{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[4,6]},{"type":"LineString","coordinates":[[4,6],[7,10]]}]}`,
+			GeometryCollection{Point{4, 6}, LineString{{4, 6}, {7, 10}}},
+		},
+		{`{"type":"GeometryCollection","geometries":[]}`, GeometryCollection{}},
+	}
+
+	for _, e := range valid {
+		if g, err := ParseGeoJSON([]byte(e.json)); assert.NoError(t, err, e.json) {
+			assert.Equal(t, e.g, g)
+		}
+
+		if b, err := json.Marshal(e.g); assert.NoError(t, err) {
+			assert.Equal(t, e.json, string(b))
+		}
+	}
+
+	invalid := []struct {
+		err  error
+		json string
+	}{
+		{ErrUnsupportedValue, `{"type":"Circle","coordinates":[30,10]}`},
+		{ErrInvalidGeoJSON, `{"type":"Point"}`},
+		{ErrInvalidGeoJSON, `{"type":"Point","coordinates":[30]}`},
+		{ErrInvalidGeoJSON, `{"type":"Point","coordinates":[[30,10]]}`},
+		{ErrInvalidGeoJSON, `{"type":"LineString","coordinates":[[30,10],[10,30,5]]}`},
+		{ErrInvalidGeoJSON, `{"type":"LineString","coordinates":[[30,10],[]]}`},
+	}
+
+	for _, e := range invalid {
+		if _, err := ParseGeoJSON([]byte(e.json)); assert.Error(t, err, e.json) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+}
+
+func TestGeoJSONDimension(t *testing.T) {
+	p := PointM{}
+	if assert.NoError(t, json.Unmarshal([]byte(`{"type":"Point","coordinates":[30,10,2]}`), &p)) {
+		assert.Equal(t, PointM{30, 10, 2}, p)
+	}
+
+	if err := json.Unmarshal([]byte(`{"type":"Point","coordinates":[30]}`), &p); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidGeoJSON)
+	}
+
+	// M is dropped from positions and zero when read back
+	measured := []struct {
+		json string
+		g    Geometry
+		dst  interface{}
+	}{
+		{`{"type":"Point","coordinates":[30,10]}`, PointM{30, 10, 2}, &PointM{}},
+		{`{"type":"Point","coordinates":[30,10,5]}`, PointZM{30, 10, 5, 2}, &PointZM{}},
+		{`{"type":"LineString","coordinates":[[30,10],[10,30]]}`, LineStringM{{30, 10, 1}, {10, 30, 2}}, &LineStringM{}},
+		{`{"type":"MultiPoint","coordinates":[[30,10,5]]}`, MultiPointZM{{30, 10, 5, 2}}, &MultiPointZM{}},
+	}
+
+	for _, e := range measured {
+		b, err := json.Marshal(e.g)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equal(t, e.json, string(b))
+
+		if assert.NoError(t, json.Unmarshal(b, e.dst)) {
+			if b, err := json.Marshal(e.dst); assert.NoError(t, err) {
+				assert.Equal(t, e.json, string(b))
+			}
+		}
+	}
+
+	if assert.NoError(t, json.Unmarshal([]byte(`{"type":"Point","coordinates":[30,10]}`), &p)) {
+		assert.Equal(t, PointM{30, 10, 0}, p)
+	}
+
+	// four ordinates are still read as ZM
+	if g, err := ParseGeoJSON([]byte(`{"type":"Point","coordinates":[30,10,5,2]}`)); assert.NoError(t, err) {
+		assert.Equal(t, PointZM{30, 10, 5, 2}, g)
+	}
+
+	ls := LineString{}
+	if err := json.Unmarshal([]byte(`{"type":"Point","coordinates":[30,10]}`), &ls); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	gc := GeometryCollectionM{}
+	if assert.NoError(t, json.Unmarshal([]byte(`{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[4,6,1]}]}`), &gc)) {
+		assert.Equal(t, GeometryCollectionM{PointM{4, 6, 1}}, gc)
+	}
+
+	empty := Point{math.NaN(), math.NaN()}
+	if b, err := json.Marshal(empty); assert.NoError(t, err) {
+		assert.Equal(t, `{"type":"Point","coordinates":[]}`, string(b))
+	}
+
+	if g, err := ParseGeoJSON([]byte(`{"type":"Point","coordinates":[]}`)); assert.NoError(t, err) {
+		p := g.(Point)
+		assert.True(t, math.IsNaN(p.X) && math.IsNaN(p.Y))
+	}
+
+	if _, err := json.Marshal(Point{math.Inf(1), 0}); assert.Error(t, err) {
+		assert.Contains(t, err.Error(), ErrUnsupportedValue.Error())
+	}
+}
+
+func TestFeature(t *testing.T) {
+	raw := `{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","id":1,"geometry":{"type":"Point","coordinates":[30,10]},"properties":{"name":"foo"}},` +
+		`{"type":"Feature","geometry":null,"properties":null}` +
+		`]}`
+
+	fc := FeatureCollection{}
+	if assert.NoError(t, json.Unmarshal([]byte(raw), &fc)) {
+		assert.Equal(t, FeatureCollection{
+			{float64(1), Point{30, 10}, map[string]interface{}{"name": "foo"}},
+			{nil, nil, nil},
+		}, fc)
+	}
+
+	if b, err := json.Marshal(fc); assert.NoError(t, err) {
+		assert.Equal(t, raw, string(b))
+	}
+
+	if b, err := json.Marshal(FeatureCollection(nil)); assert.NoError(t, err) {
+		assert.Equal(t, `{"type":"FeatureCollection","features":[]}`, string(b))
+	}
+
+	f := Feature{}
+	if err := json.Unmarshal([]byte(`{"type":"Point","coordinates":[30,10]}`), &f); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidGeoJSON)
+	}
+
+	if err := json.Unmarshal([]byte(`{"type":"Feature","geometry":{"type":"Point"}}`), &f); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidGeoJSON)
+	}
+}
+
+func TestGeoJSONCurves(t *testing.T) {
+	cs := CircularString{{-1, 0}, {0, 1}, {1, 0}}
+	if b, err := json.Marshal(cs); assert.NoError(t, err) {
+		if g, err := ParseGeoJSON(b); assert.NoError(t, err) {
+			assert.Equal(t, Linearize(cs, DefaultSegments), g)
+		}
+	}
+
+	ms := MultiSurfaceZ{CurvePolygonZ{CircularStringZ{{-1, 0, 1}, {1, 0, 1}, {-1, 0, 1}}}}
+	if b, err := json.Marshal(ms); assert.NoError(t, err) {
+		if g, err := ParseGeoJSON(b); assert.NoError(t, err) {
+			assert.Equal(t, Linearize(ms, DefaultSegments), g)
+		}
+	}
+
+	if b, err := json.Marshal(CompoundCurveM{}); assert.NoError(t, err) {
+		assert.Equal(t, `{"type":"LineString","coordinates":[]}`, string(b))
+	}
+
+	if _, err := json.Marshal(Triangle{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+}
+
+func TestGeoJSONWrappers(t *testing.T) {
+	const point = `{"type":"Point","coordinates":[30,10]}`
+
+	valid := []struct {
+		v   interface{}
+		dst interface{}
+	}{
+		{Geom{Point{30, 10}}, &Geom{}},
+		{EWKB{4326, Point{30, 10}}, &EWKB{}},
+		{Spatialite{4326, Point{30, 10}}, &Spatialite{}},
+		{GeoPackage{4326, Point{30, 10}}, &GeoPackage{}},
+	}
+
+	for _, e := range valid {
+		b, err := json.Marshal(e.v)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		assert.Equal(t, point, string(b))
+
+		if assert.NoError(t, json.Unmarshal(b, e.dst)) {
+			assert.Equal(t, e.v, reflect.ValueOf(e.dst).Elem().Interface())
+		}
+	}
+
+	if b, err := json.Marshal(Geom{View(Marshal(Point{30, 10}))}); assert.NoError(t, err) {
+		assert.Equal(t, point, string(b))
+	}
+
+	if b, err := json.Marshal(Geom{EWKB{4326, CircularString{}}}); assert.NoError(t, err) {
+		assert.Equal(t, `{"type":"LineString","coordinates":[]}`, string(b))
+	}
+
+	g := Geom{Point{1, 1}}
+	if b, err := json.Marshal(Geom{}); assert.NoError(t, err) {
+		assert.Equal(t, "null", string(b))
+		if assert.NoError(t, json.Unmarshal(b, &g)) {
+			assert.Nil(t, g.Geometry)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"type":"Circle"}`), &EWKB{}); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+}
@@ -11,85 +11,322 @@ func New(b []byte) (Geometry, error) {
 }
 
 func ReadGeometry(b []byte) ([]byte, Geometry, error) {
+	return newDecoder(b).geometry(b)
+}
+
+func (d *decoder) geometry(b []byte) ([]byte, Geometry, error) {
+	d.expected, d.actual = 0, 0
 	if len(b) < HeaderSize {
-		return nil, nil, ErrInvalidStorage
+		return nil, nil, d.fail(b, ErrInvalidStorage)
 	}
 
 	dec := byteOrder(b[0])
 	if dec == nil {
-		return nil, nil, ErrInvalidStorage
+		return nil, nil, d.fail(b, ErrInvalidStorage)
 	}
 
-	_, kind := readUint32(b[ByteOrderSize:], dec)
+	_, code := readUint32(b[ByteOrderSize:], dec)
 
 	var g Geometry
 	var err error
-	switch kind {
+	switch kind(code) {
 	case GeomPoint:
-		b, g, err = ReadPoint(b)
+		b, g, err = d.point(b)
 	case GeomLineString:
-		b, g, err = ReadLineString(b)
+		b, g, err = d.lineString(b, nil)
 	case GeomPolygon:
-		b, g, err = ReadPolygon(b)
+		b, g, err = d.polygon(b, nil)
 	case GeomMultiPoint:
-		b, g, err = ReadMultiPoint(b)
+		b, g, err = d.multiPoint(b, nil)
 	case GeomMultiLineString:
-		b, g, err = ReadMultiLineString(b)
+		b, g, err = d.multiLineString(b, nil)
 	case GeomMultiPolygon:
-		b, g, err = ReadMultiPolygon(b)
+		b, g, err = d.multiPolygon(b, nil)
 	case GeomCollection:
-		b, g, err = ReadGeometryCollection(b)
+		b, g, err = d.geometryCollection(b)
+	case GeomCircularString:
+		b, g, err = d.circularString(b, nil)
+	case GeomCompoundCurve:
+		b, g, err = d.compoundCurve(b)
+	case GeomCurvePolygon:
+		b, g, err = d.curvePolygon(b)
+	case GeomMultiCurve:
+		b, g, err = d.multiCurve(b)
+	case GeomMultiSurface:
+		b, g, err = d.multiSurface(b)
+	case GeomPolyhedralSurface:
+		b, g, err = d.polyhedralSurface(b, nil)
+	case GeomTIN:
+		b, g, err = d.tin(b, nil)
+	case GeomTriangle:
+		b, g, err = d.triangle(b, nil)
+	case GeomPointZ:
+		b, g, err = d.pointZ(b)
+	case GeomLineStringZ:
+		b, g, err = d.lineStringZ(b, nil)
+	case GeomPolygonZ:
+		b, g, err = d.polygonZ(b, nil)
+	case GeomMultiPointZ:
+		b, g, err = d.multiPointZ(b, nil)
+	case GeomMultiLineStringZ:
+		b, g, err = d.multiLineStringZ(b, nil)
+	case GeomMultiPolygonZ:
+		b, g, err = d.multiPolygonZ(b, nil)
+	case GeomCollectionZ:
+		b, g, err = d.geometryCollectionZ(b)
+	case GeomCircularStringZ:
+		b, g, err = d.circularStringZ(b, nil)
+	case GeomCompoundCurveZ:
+		b, g, err = d.compoundCurveZ(b)
+	case GeomCurvePolygonZ:
+		b, g, err = d.curvePolygonZ(b)
+	case GeomMultiCurveZ:
+		b, g, err = d.multiCurveZ(b)
+	case GeomMultiSurfaceZ:
+		b, g, err = d.multiSurfaceZ(b)
+	case GeomPolyhedralSurfaceZ:
+		b, g, err = d.polyhedralSurfaceZ(b, nil)
+	case GeomTINZ:
+		b, g, err = d.tinZ(b, nil)
+	case GeomTriangleZ:
+		b, g, err = d.triangleZ(b, nil)
+	case GeomPointM:
+		b, g, err = d.pointM(b)
+	case GeomLineStringM:
+		b, g, err = d.lineStringM(b, nil)
+	case GeomPolygonM:
+		b, g, err = d.polygonM(b, nil)
+	case GeomMultiPointM:
+		b, g, err = d.multiPointM(b, nil)
+	case GeomMultiLineStringM:
+		b, g, err = d.multiLineStringM(b, nil)
+	case GeomMultiPolygonM:
+		b, g, err = d.multiPolygonM(b, nil)
+	case GeomCollectionM:
+		b, g, err = d.geometryCollectionM(b)
+	case GeomCircularStringM:
+		b, g, err = d.circularStringM(b, nil)
+	case GeomCompoundCurveM:
+		b, g, err = d.compoundCurveM(b)
+	case GeomCurvePolygonM:
+		b, g, err = d.curvePolygonM(b)
+	case GeomMultiCurveM:
+		b, g, err = d.multiCurveM(b)
+	case GeomMultiSurfaceM:
+		b, g, err = d.multiSurfaceM(b)
+	case GeomPolyhedralSurfaceM:
+		b, g, err = d.polyhedralSurfaceM(b, nil)
+	case GeomTINM:
+		b, g, err = d.tinM(b, nil)
+	case GeomTriangleM:
+		b, g, err = d.triangleM(b, nil)
+	case GeomPointZM:
+		b, g, err = d.pointZM(b)
+	case GeomLineStringZM:
+		b, g, err = d.lineStringZM(b, nil)
+	case GeomPolygonZM:
+		b, g, err = d.polygonZM(b, nil)
+	case GeomMultiPointZM:
+		b, g, err = d.multiPointZM(b, nil)
+	case GeomMultiLineStringZM:
+		b, g, err = d.multiLineStringZM(b, nil)
+	case GeomMultiPolygonZM:
+		b, g, err = d.multiPolygonZM(b, nil)
+	case GeomCollectionZM:
+		b, g, err = d.geometryCollectionZM(b)
+	case GeomCircularStringZM:
+		b, g, err = d.circularStringZM(b, nil)
+	case GeomCompoundCurveZM:
+		b, g, err = d.compoundCurveZM(b)
+	case GeomCurvePolygonZM:
+		b, g, err = d.curvePolygonZM(b)
+	case GeomMultiCurveZM:
+		b, g, err = d.multiCurveZM(b)
+	case GeomMultiSurfaceZM:
+		b, g, err = d.multiSurfaceZM(b)
+	case GeomPolyhedralSurfaceZM:
+		b, g, err = d.polyhedralSurfaceZM(b, nil)
+	case GeomTINZM:
+		b, g, err = d.tinZM(b, nil)
+	case GeomTriangleZM:
+		b, g, err = d.triangleZM(b, nil)
 	default:
-		return nil, nil, ErrUnsupportedValue
+		d.actual = kind(code)
+		return nil, nil, d.fail(b, ErrUnsupportedValue)
 	}
 
 	return b, g, err
 }
 
-func (gc *GeometryCollection) Scan(src interface{}) error {
-	b, ok := src.([]byte)
-	if !ok {
-		return ErrInvalidStorage
+// Geom is geometry of any kind, scanned according to kind in its header.
+type Geom struct {
+	Geometry
+}
+
+// Equal compares geometry with other, unwrapping other when it is Geom too.
+func (g Geom) Equal(other Geometry) bool {
+	if o, ok := other.(Geom); ok {
+		other = o.Geometry
 	}
+	return g.Geometry != nil && other != nil && g.Geometry.Equal(other)
+}
 
-	_, tmp, err := ReadGeometryCollection(b)
+func (g *Geom) Scan(src interface{}) error {
+	b, err := scanBytes(src)
 	if err != nil {
 		return err
 	}
+	return g.UnmarshalBinary(b)
+}
 
-	*gc = tmp
-	return err
+func (g Geom) Value() (driver.Value, error) {
+	if g.Geometry == nil {
+		return nil, ErrUnsupportedValue
+	}
+
+	if v, ok := g.Geometry.(driver.Valuer); ok {
+		return v.Value()
+	}
+	return Marshal(g.Geometry), nil
+}
+
+func (g Geom) MarshalBinary() ([]byte, error) {
+	if g.Geometry == nil {
+		return nil, ErrUnsupportedValue
+	}
+	return g.AppendWKB(make([]byte, 0, g.ByteSize())), nil
+}
+
+func (g *Geom) UnmarshalBinary(b []byte) error {
+	return g.unmarshal(newDecoder(b), b)
+}
+
+func (g *Geom) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.geometry(b)
+	if err != nil {
+		return err
+	}
+
+	g.Geometry = tmp
+	return nil
+}
+
+func (g Geom) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	g.encode(e)
+	return e.release()
+}
+
+func (g Geom) encode(e *encoder) {
+	e.geometry(g.Geometry)
+}
+
+func (gc *GeometryCollection) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return gc.UnmarshalBinary(b)
 }
 
 func (gc GeometryCollection) Value() (driver.Value, error) {
-	buf := bytes.NewBuffer(make([]byte, 0, gc.ByteSize()))
-	gc.Write(buf)
-	return buf.Bytes(), nil
+	return gc.MarshalBinary()
+}
+
+func (gc GeometryCollection) MarshalBinary() ([]byte, error) {
+	return gc.AppendWKB(make([]byte, 0, gc.ByteSize())), nil
+}
+
+func (gc *GeometryCollection) UnmarshalBinary(b []byte) error {
+	return gc.unmarshal(newDecoder(b), b)
+}
+
+func (gc *GeometryCollection) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.geometryCollection(b)
+	if err != nil {
+		return err
+	}
+
+	*gc = tmp
+	return nil
 }
 
 func ReadGeometryCollection(b []byte) ([]byte, GeometryCollection, error) {
-	if len(b) < HeaderSize+CountSize {
-		return nil, nil, ErrInvalidStorage
+	return newDecoder(b).geometryCollection(b)
+}
+
+func (d *decoder) geometryCollection(b []byte) ([]byte, GeometryCollection, error) {
+	if !d.enter() {
+		return nil, nil, d.fail(b, ErrLimitExceeded)
 	}
+	defer d.leave()
 
-	b, dec, err := header(b, GeomCollection)
+	b, dec, err := d.header(b, GeomCollection)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	b, n := readCount(b, dec)
+	b, n, err := d.count(b, dec, HeaderSize)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	gc := make([]Geometry, n)
+	d.push("")
 	for i := 0; i < n; i++ {
-		b, gc[i], err = ReadGeometry(b)
+		d.at(i)
+		b, gc[i], err = d.geometry(b)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
+	d.pop()
 
 	return b, gc, nil
 }
 
+// IsEmpty reports whether collection has no members other than empty geometries.
+func (gc GeometryCollection) IsEmpty() bool {
+	return isEmpty(gc)
+}
+
+func (gc GeometryCollection) Kind() Kind {
+	return GeomCollection
+}
+
+// Dimension returns highest dimension of members, 0 when collection has none.
+func (gc GeometryCollection) Dimension() int {
+	return dimensionAll(gc)
+}
+
+func (gc GeometryCollection) Envelope() Envelope {
+	return envelopeAll(gc)
+}
+
+func (gc GeometryCollection) NumPoints() int {
+	return numPointsAll(gc)
+}
+
+func (gc GeometryCollection) Equal(other Geometry) bool {
+	o, ok := other.(GeometryCollection)
+	return ok && equalAll(gc, o)
+}
+
+// Centroid returns center of mass of members of highest dimension, empty point when it is empty.
+func (gc GeometryCollection) Centroid() Point {
+	c := centroid{}
+	gc.addCentroid(&c)
+	return c.result()
+}
+
+func (gc GeometryCollection) addCentroid(c *centroid) {
+	c.all(gc)
+}
+
+func (gc GeometryCollection) addShape(s *shape) {
+	s.all(gc)
+}
+
 func (gc GeometryCollection) ByteSize() int {
 	size := HeaderSize + CountSize
 	for _, g := range gc {
@@ -99,9 +336,91 @@ func (gc GeometryCollection) ByteSize() int {
 }
 
 func (gc GeometryCollection) Write(buf *bytes.Buffer) {
-	writeHeader(buf, GeomCollection)
-	writeCount(buf, len(gc))
+	buf.Write(gc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (gc GeometryCollection) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	gc.encode(e)
+	return e.release()
+}
+
+func (gc GeometryCollection) encode(e *encoder) {
+	e.header(GeomCollection)
+	e.count(len(gc))
 	for _, g := range gc {
-		g.Write(buf)
+		e.geometry(g)
+	}
+}
+
+func isEmpty(gs []Geometry) bool {
+	for _, g := range gs {
+		if !g.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// coord is point of any dimension.
+type coord interface {
+	Point | PointZ | PointM | PointZM
+	IsEmpty() bool
+	xy() (x, y float64)
+}
+
+func equalPoint[T coord](a, b T) bool {
+	return a == b || a.IsEmpty() && b.IsEmpty()
+}
+
+func equalPoints[T coord](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equalPoint(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalAll[T Geometry](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// numPoints returns number of points which are not empty.
+func numPoints[T coord](pts []T) int {
+	n := 0
+	for _, p := range pts {
+		if !p.IsEmpty() {
+			n++
+		}
+	}
+	return n
+}
+
+func numPointsAll[T Geometry](gs []T) int {
+	n := 0
+	for _, g := range gs {
+		n += g.NumPoints()
+	}
+	return n
+}
+
+// dimensionAll returns highest dimension of geometries, 0 when there are none.
+func dimensionAll(gs []Geometry) int {
+	dim := 0
+	for _, g := range gs {
+		dim = max(dim, g.Dimension())
 	}
+	return dim
 }
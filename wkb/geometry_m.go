@@ -0,0 +1,138 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+func (gc *GeometryCollectionM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return gc.UnmarshalBinary(b)
+}
+
+func (gc GeometryCollectionM) Value() (driver.Value, error) {
+	return gc.MarshalBinary()
+}
+
+func (gc GeometryCollectionM) MarshalBinary() ([]byte, error) {
+	return gc.AppendWKB(make([]byte, 0, gc.ByteSize())), nil
+}
+
+func (gc *GeometryCollectionM) UnmarshalBinary(b []byte) error {
+	return gc.unmarshal(newDecoder(b), b)
+}
+
+func (gc *GeometryCollectionM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.geometryCollectionM(b)
+	if err != nil {
+		return err
+	}
+
+	*gc = tmp
+	return nil
+}
+
+func ReadGeometryCollectionM(b []byte) ([]byte, GeometryCollectionM, error) {
+	return newDecoder(b).geometryCollectionM(b)
+}
+
+func (d *decoder) geometryCollectionM(b []byte) ([]byte, GeometryCollectionM, error) {
+	if !d.enter() {
+		return nil, nil, d.fail(b, ErrLimitExceeded)
+	}
+	defer d.leave()
+
+	b, dec, err := d.header(b, GeomCollectionM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gc := make([]Geometry, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, gc[i], err = d.geometry(b)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, gc, nil
+}
+
+// IsEmpty reports whether collection has no members other than empty geometries.
+func (gc GeometryCollectionM) IsEmpty() bool {
+	return isEmpty(gc)
+}
+
+func (gc GeometryCollectionM) Kind() Kind {
+	return GeomCollectionM
+}
+
+// Dimension returns highest dimension of members, 0 when collection has none.
+func (gc GeometryCollectionM) Dimension() int {
+	return dimensionAll(gc)
+}
+
+func (gc GeometryCollectionM) Envelope() Envelope {
+	return envelopeAll(gc)
+}
+
+func (gc GeometryCollectionM) NumPoints() int {
+	return numPointsAll(gc)
+}
+
+func (gc GeometryCollectionM) Equal(other Geometry) bool {
+	o, ok := other.(GeometryCollectionM)
+	return ok && equalAll(gc, o)
+}
+
+// Centroid returns center of mass of members of highest dimension, empty point when it is empty.
+func (gc GeometryCollectionM) Centroid() Point {
+	c := centroid{}
+	gc.addCentroid(&c)
+	return c.result()
+}
+
+func (gc GeometryCollectionM) addCentroid(c *centroid) {
+	c.all(gc)
+}
+
+func (gc GeometryCollectionM) addShape(s *shape) {
+	s.all(gc)
+}
+
+func (gc GeometryCollectionM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, g := range gc {
+		size += g.ByteSize()
+	}
+	return size
+}
+
+func (gc GeometryCollectionM) Write(buf *bytes.Buffer) {
+	buf.Write(gc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (gc GeometryCollectionM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	gc.encode(e)
+	return e.release()
+}
+
+func (gc GeometryCollectionM) encode(e *encoder) {
+	e.header(GeomCollectionM)
+	e.count(len(gc))
+	for _, g := range gc {
+		e.geometry(g)
+	}
+}
@@ -0,0 +1,67 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var rawGeometryCollectionM = []byte{
+	0x01, 0xd7, 0x07, 0x00, 0x00, // header
+	0x02, 0x00, 0x00, 0x00, // numgeometry - 2
+	0x01, 0xd1, 0x07, 0x00, 0x00, // geometry 1 - point
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+	0x01, 0x01, 0x00, 0x00, 0x00, // geometry 2 - 2D point
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+}
+
+func TestGeometryM(t *testing.T) {
+	if g, err := New(rawPointM); assert.NoError(t, err) {
+		assert.IsType(t, PointM{}, g)
+	}
+
+	if g, err := New(rawMultiPointM); assert.NoError(t, err) {
+		assert.IsType(t, MultiPointM{}, g)
+	}
+
+	if g, err := New(rawLineStringM); assert.NoError(t, err) {
+		assert.IsType(t, LineStringM{}, g)
+	}
+
+	if g, err := New(rawMultiLineStringM); assert.NoError(t, err) {
+		assert.IsType(t, MultiLineStringM{}, g)
+	}
+
+	if g, err := New(rawPolygonM); assert.NoError(t, err) {
+		assert.IsType(t, PolygonM{}, g)
+	}
+
+	if g, err := New(rawMultiPolygonM); assert.NoError(t, err) {
+		assert.IsType(t, MultiPolygonM{}, g)
+	}
+
+	if g, err := New(rawGeometryCollectionM); assert.NoError(t, err) {
+		assert.IsType(t, GeometryCollectionM{}, g)
+	}
+}
+
+func TestGeometryCollectionM(t *testing.T) {
+	if err := (&GeometryCollectionM{}).Scan(rawGeometryCollection); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	gc := GeometryCollectionM{}
+	if err := gc.Scan(rawGeometryCollectionM); assert.NoError(t, err) {
+		assert.Equal(t, GeometryCollectionM{
+			PointM{4, 6, 1},
+			Point{7, 10},
+		}, gc)
+	}
+
+	if raw, err := gc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawGeometryCollectionM, raw)
+	}
+}
@@ -1,6 +1,7 @@
 package wkb
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -49,7 +50,7 @@ func TestGeometry(t *testing.T) {
 
 	for _, e := range invalid {
 		if _, err := New(e.b); assert.Error(t, err) {
-			assert.Exactly(t, e.err, err)
+			assert.ErrorIs(t, err, e.err)
 		}
 	}
 
@@ -126,12 +127,12 @@ func TestGeometryCollection(t *testing.T) {
 	for _, e := range invalid {
 		gc := GeometryCollection{}
 		if err := gc.Scan(e.b); assert.Error(t, err) {
-			assert.Exactly(t, e.err, err)
+			assert.ErrorIs(t, err, e.err)
 		}
 	}
 
 	if err := (&GeometryCollection{}).Scan(""); assert.Error(t, err) {
-		assert.Exactly(t, ErrInvalidStorage, err)
+		assert.ErrorIs(t, err, ErrInvalidStorage)
 	}
 
 	gc := GeometryCollection{}
@@ -146,3 +147,170 @@ func TestGeometryCollection(t *testing.T) {
 		assert.Equal(t, rawGeometryCollection, raw)
 	}
 }
+
+func TestEmpty(t *testing.T) {
+	rawEmptyPoint := []byte{
+		0x01, 0x01, 0x00, 0x00, 0x00, // header
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x7f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x7f,
+	}
+
+	p := Point{}
+	if assert.NoError(t, p.Scan(rawEmptyPoint)) {
+		assert.True(t, p.IsEmpty())
+		assert.True(t, p.Equal(EmptyPoint()))
+	}
+	raw, err := EmptyPoint().Value()
+	if assert.NoError(t, err) {
+		assert.Equal(t, rawEmptyPoint, raw)
+	}
+	// any NaN is written in canonical form
+	nan := math.Float64frombits(0x7ff8000000000001)
+	assert.Equal(t, rawEmptyPoint, Marshal(Point{nan, nan}))
+
+	empty := []Geometry{
+		EmptyPoint(), LineString{}, Polygon{}, Polygon{LinearRing{}},
+		MultiPoint{}, MultiPoint{EmptyPoint()}, MultiLineString{}, MultiLineString{LineString{}},
+		MultiPolygon{}, MultiPolygon{Polygon{}}, GeometryCollection{}, GeometryCollection{EmptyPoint(), Polygon{}},
+		EmptyPointZ(), LineStringZ{}, PolygonZ{}, MultiPointZ{EmptyPointZ()}, MultiLineStringZ{}, MultiPolygonZ{}, GeometryCollectionZ{},
+		EmptyPointM(), LineStringM{}, PolygonM{}, MultiPointM{EmptyPointM()}, MultiLineStringM{}, MultiPolygonM{}, GeometryCollectionM{},
+		EmptyPointZM(), LineStringZM{}, PolygonZM{}, MultiPointZM{EmptyPointZM()}, MultiLineStringZM{}, MultiPolygonZM{}, GeometryCollectionZM{},
+	}
+
+	for _, g := range empty {
+		assert.True(t, g.IsEmpty(), "Expected %#v to be empty", g)
+
+		b := Marshal(g)
+		actual, err := New(b)
+		if assert.NoError(t, err) {
+			assert.IsType(t, g, actual)
+			assert.True(t, actual.IsEmpty())
+			assert.Equal(t, b, Marshal(actual))
+		}
+	}
+
+	nonEmpty := []Geometry{
+		Point{0, 0}, LineString{{1, 2}}, Polygon{{}, {{1, 2}}}, MultiPoint{EmptyPoint(), {1, 2}},
+		MultiLineString{{}, {{1, 2}}}, MultiPolygon{{{{1, 2}}}}, GeometryCollection{EmptyPoint(), Point{1, 2}},
+		PointZM{1, 2, math.NaN(), math.NaN()},
+	}
+	for _, g := range nonEmpty {
+		assert.False(t, g.IsEmpty(), "Expected %#v not to be empty", g)
+	}
+}
+
+func TestGeometryProperties(t *testing.T) {
+	valid := []struct {
+		g         Geometry
+		kind      Kind
+		dim       int
+		numPoints int
+		env       Envelope
+	}{
+		{Point{1, 2}, GeomPoint, 0, 1, Envelope{1, 2, 1, 2}},
+		{EmptyPointZ(), GeomPointZ, 0, 0, EmptyEnvelope()},
+		{MultiPointM{{1, 2, 3}, EmptyPointM(), {-1, 5, 3}}, GeomMultiPointM, 0, 2, Envelope{-1, 2, 1, 5}},
+		{LineStringZM{{1, 2, 3, 4}, {3, -2, 1, 0}}, GeomLineStringZM, 1, 2, Envelope{1, -2, 3, 2}},
+		{Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 0}}, {{1, 1}, {2, 1}, {1, 2}, {1, 1}}}, GeomPolygon, 2, 8, Envelope{0, 0, 4, 4}},
+		{MultiLineString{{{0, 0}, {1, 1}}, {{5, -1}, {6, 0}}}, GeomMultiLineString, 1, 4, Envelope{0, -1, 6, 1}},
+		{MultiPolygonZ{}, GeomMultiPolygonZ, 2, 0, EmptyEnvelope()},
+		{GeometryCollection{}, GeomCollection, 0, 0, EmptyEnvelope()},
+		{GeometryCollection{Point{9, 9}, LineString{{0, 0}, {1, 1}}}, GeomCollection, 1, 3, Envelope{0, 0, 9, 9}},
+		{CompoundCurve{LineString{{0, 0}, {1, 0}}, CircularString{{1, 0}, {2, 1}, {3, 0}}}, GeomCompoundCurve, 1, 5, Envelope{0, 0, 3, 1}},
+		{TIN{{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}}, GeomTIN, 2, 4, Envelope{0, 0, 1, 1}},
+		{Spatialite{4326, LineString{{1, 2}, {3, 4}}}, GeomLineString, 1, 2, Envelope{1, 2, 3, 4}},
+	}
+
+	for _, e := range valid {
+		assert.Equal(t, e.kind, e.g.Kind(), "%#v", e.g)
+		assert.Equal(t, e.dim, e.g.Dimension(), "%#v", e.g)
+		assert.Equal(t, e.numPoints, e.g.NumPoints(), "%#v", e.g)
+		assert.Equal(t, e.env, e.g.Envelope(), "%#v", e.g)
+		assert.True(t, e.g.Equal(e.g), "%#v", e.g)
+	}
+}
+
+func TestGeometryEqual(t *testing.T) {
+	equal := [][2]Geometry{
+		{Point{1, 2}, Point{1, 2}},
+		{EmptyPoint(), Point{math.NaN(), math.NaN()}},
+		{MultiPoint{EmptyPoint(), {1, 2}}, MultiPoint{EmptyPoint(), {1, 2}}},
+		{PolygonZ{{{0, 0, 1}, {1, 0, 1}, {0, 1, 1}, {0, 0, 1}}}, PolygonZ{{{0, 0, 1}, {1, 0, 1}, {0, 1, 1}, {0, 0, 1}}}},
+		{GeometryCollection{Point{1, 2}, LineString{{0, 0}, {1, 1}}}, GeometryCollection{Point{1, 2}, LineString{{0, 0}, {1, 1}}}},
+		{MultiCurve{CircularString{{0, 0}, {1, 1}, {2, 0}}}, MultiCurve{CircularString{{0, 0}, {1, 1}, {2, 0}}}},
+		{EWKB{4326, Point{1, 2}}, Point{1, 2}},
+		{EWKB{4326, Point{1, 2}}, EWKB{4326, Point{1, 2}}},
+		{Geom{Point{1, 2}}, Geom{Point{1, 2}}},
+	}
+	for _, e := range equal {
+		assert.True(t, e[0].Equal(e[1]), "Expected %#v to equal %#v", e[0], e[1])
+	}
+
+	notEqual := [][2]Geometry{
+		{Point{1, 2}, Point{2, 1}},
+		{Point{1, 2}, PointZ{1, 2, 0}},
+		{Point{1, 2}, MultiPoint{{1, 2}}},
+		{LineString{{0, 0}, {1, 1}}, LineString{{1, 1}, {0, 0}}},
+		{LineString{{0, 0}, {1, 1}}, CircularString{{0, 0}, {1, 1}}},
+		{Polygon{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}, Triangle{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}},
+		{MultiPolygon{}, MultiPolygon{Polygon{}}},
+		{GeometryCollection{Point{1, 2}}, GeometryCollection{Point{1, 3}}},
+		{PointZ{1, 2, 3}, PointZ{1, 2, math.NaN()}},
+		{Spatialite{4326, Point{1, 2}}, Spatialite{3857, Point{1, 2}}},
+		{Geom{}, Geom{}},
+	}
+	for _, e := range notEqual {
+		assert.False(t, e[0].Equal(e[1]), "Expected %#v not to equal %#v", e[0], e[1])
+	}
+}
+
+func TestKindString(t *testing.T) {
+	assert.Equal(t, "Point", Kind(GeomPoint).String())
+	assert.Equal(t, "PolyhedralSurfaceZ", Kind(GeomPolyhedralSurfaceZ).String())
+	assert.Equal(t, "GeometryCollectionZM", Kind(GeomCollectionZM).String())
+	assert.Equal(t, "Kind(42)", Kind(42).String())
+	assert.Equal(t, "Kind(4001)", Kind(4001).String())
+}
+
+func TestGeom(t *testing.T) {
+	valid := map[string]struct {
+		b        []byte
+		expected Geometry
+	}{
+		"point":      {rawPoint, Point{30, 10}},
+		"polygon":    {rawPolygon, Polygon{{{30, 10}, {40, 40}, {20, 40}, {10, 20}, {30, 10}}}},
+		"pointz":     {rawPointZ, PointZ{30, 10, 5}},
+		"collection": {rawGeometryCollection, GeometryCollection{Point{4, 6}, LineString{{4, 6}, {7, 10}}}},
+		"ewkb":       {rawEWKBPoint, Point{30, 10}},
+	}
+
+	for name, e := range valid {
+		g := Geom{}
+		if assert.NoError(t, g.Scan(e.b), name) {
+			assert.Equal(t, e.expected, g.Geometry, name)
+		}
+	}
+
+	g := Geom{Point{1, 2}}
+	if err := g.Scan("POINT (1 2)"); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+		assert.Equal(t, Point{1, 2}, g.Geometry)
+	}
+
+	if err := g.Scan([]byte{0x01, 0x63, 0x00, 0x00, 0x00}); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	if raw, err := (Geom{MultiPolygon{}}).Value(); assert.NoError(t, err) {
+		assert.Equal(t, Marshal(MultiPolygon{}), raw)
+	}
+
+	if raw, err := (Geom{Spatialite{4326, Point{30, 10}}}).Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawSpatialitePoint, raw)
+	}
+
+	_, err := Geom{}.Value()
+	assert.ErrorIs(t, err, ErrUnsupportedValue)
+
+	assert.Equal(t, rawBigEndianPoint, Marshal(Geom{Point{30, 10}}, WithByteOrder(BigEndian)))
+}
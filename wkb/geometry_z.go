@@ -0,0 +1,138 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+func (gc *GeometryCollectionZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return gc.UnmarshalBinary(b)
+}
+
+func (gc GeometryCollectionZ) Value() (driver.Value, error) {
+	return gc.MarshalBinary()
+}
+
+func (gc GeometryCollectionZ) MarshalBinary() ([]byte, error) {
+	return gc.AppendWKB(make([]byte, 0, gc.ByteSize())), nil
+}
+
+func (gc *GeometryCollectionZ) UnmarshalBinary(b []byte) error {
+	return gc.unmarshal(newDecoder(b), b)
+}
+
+func (gc *GeometryCollectionZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.geometryCollectionZ(b)
+	if err != nil {
+		return err
+	}
+
+	*gc = tmp
+	return nil
+}
+
+func ReadGeometryCollectionZ(b []byte) ([]byte, GeometryCollectionZ, error) {
+	return newDecoder(b).geometryCollectionZ(b)
+}
+
+func (d *decoder) geometryCollectionZ(b []byte) ([]byte, GeometryCollectionZ, error) {
+	if !d.enter() {
+		return nil, nil, d.fail(b, ErrLimitExceeded)
+	}
+	defer d.leave()
+
+	b, dec, err := d.header(b, GeomCollectionZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gc := make([]Geometry, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, gc[i], err = d.geometry(b)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, gc, nil
+}
+
+// IsEmpty reports whether collection has no members other than empty geometries.
+func (gc GeometryCollectionZ) IsEmpty() bool {
+	return isEmpty(gc)
+}
+
+func (gc GeometryCollectionZ) Kind() Kind {
+	return GeomCollectionZ
+}
+
+// Dimension returns highest dimension of members, 0 when collection has none.
+func (gc GeometryCollectionZ) Dimension() int {
+	return dimensionAll(gc)
+}
+
+func (gc GeometryCollectionZ) Envelope() Envelope {
+	return envelopeAll(gc)
+}
+
+func (gc GeometryCollectionZ) NumPoints() int {
+	return numPointsAll(gc)
+}
+
+func (gc GeometryCollectionZ) Equal(other Geometry) bool {
+	o, ok := other.(GeometryCollectionZ)
+	return ok && equalAll(gc, o)
+}
+
+// Centroid returns center of mass of members of highest dimension, empty point when it is empty.
+func (gc GeometryCollectionZ) Centroid() Point {
+	c := centroid{}
+	gc.addCentroid(&c)
+	return c.result()
+}
+
+func (gc GeometryCollectionZ) addCentroid(c *centroid) {
+	c.all(gc)
+}
+
+func (gc GeometryCollectionZ) addShape(s *shape) {
+	s.all(gc)
+}
+
+func (gc GeometryCollectionZ) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, g := range gc {
+		size += g.ByteSize()
+	}
+	return size
+}
+
+func (gc GeometryCollectionZ) Write(buf *bytes.Buffer) {
+	buf.Write(gc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (gc GeometryCollectionZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	gc.encode(e)
+	return e.release()
+}
+
+func (gc GeometryCollectionZ) encode(e *encoder) {
+	e.header(GeomCollectionZ)
+	e.count(len(gc))
+	for _, g := range gc {
+		e.geometry(g)
+	}
+}
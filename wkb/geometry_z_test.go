@@ -0,0 +1,67 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var rawGeometryCollectionZ = []byte{
+	0x01, 0xef, 0x03, 0x00, 0x00, // header
+	0x02, 0x00, 0x00, 0x00, // numgeometry - 2
+	0x01, 0xe9, 0x03, 0x00, 0x00, // geometry 1 - point
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+	0x01, 0x01, 0x00, 0x00, 0x00, // geometry 2 - 2D point
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+}
+
+func TestGeometryZ(t *testing.T) {
+	if g, err := New(rawPointZ); assert.NoError(t, err) {
+		assert.IsType(t, PointZ{}, g)
+	}
+
+	if g, err := New(rawMultiPointZ); assert.NoError(t, err) {
+		assert.IsType(t, MultiPointZ{}, g)
+	}
+
+	if g, err := New(rawLineStringZ); assert.NoError(t, err) {
+		assert.IsType(t, LineStringZ{}, g)
+	}
+
+	if g, err := New(rawMultiLineStringZ); assert.NoError(t, err) {
+		assert.IsType(t, MultiLineStringZ{}, g)
+	}
+
+	if g, err := New(rawPolygonZ); assert.NoError(t, err) {
+		assert.IsType(t, PolygonZ{}, g)
+	}
+
+	if g, err := New(rawMultiPolygonZ); assert.NoError(t, err) {
+		assert.IsType(t, MultiPolygonZ{}, g)
+	}
+
+	if g, err := New(rawGeometryCollectionZ); assert.NoError(t, err) {
+		assert.IsType(t, GeometryCollectionZ{}, g)
+	}
+}
+
+func TestGeometryCollectionZ(t *testing.T) {
+	if err := (&GeometryCollectionZ{}).Scan(rawGeometryCollection); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	gc := GeometryCollectionZ{}
+	if err := gc.Scan(rawGeometryCollectionZ); assert.NoError(t, err) {
+		assert.Equal(t, GeometryCollectionZ{
+			PointZ{4, 6, 1},
+			Point{7, 10},
+		}, gc)
+	}
+
+	if raw, err := gc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawGeometryCollectionZ, raw)
+	}
+}
@@ -0,0 +1,138 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+func (gc *GeometryCollectionZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return gc.UnmarshalBinary(b)
+}
+
+func (gc GeometryCollectionZM) Value() (driver.Value, error) {
+	return gc.MarshalBinary()
+}
+
+func (gc GeometryCollectionZM) MarshalBinary() ([]byte, error) {
+	return gc.AppendWKB(make([]byte, 0, gc.ByteSize())), nil
+}
+
+func (gc *GeometryCollectionZM) UnmarshalBinary(b []byte) error {
+	return gc.unmarshal(newDecoder(b), b)
+}
+
+func (gc *GeometryCollectionZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.geometryCollectionZM(b)
+	if err != nil {
+		return err
+	}
+
+	*gc = tmp
+	return nil
+}
+
+func ReadGeometryCollectionZM(b []byte) ([]byte, GeometryCollectionZM, error) {
+	return newDecoder(b).geometryCollectionZM(b)
+}
+
+func (d *decoder) geometryCollectionZM(b []byte) ([]byte, GeometryCollectionZM, error) {
+	if !d.enter() {
+		return nil, nil, d.fail(b, ErrLimitExceeded)
+	}
+	defer d.leave()
+
+	b, dec, err := d.header(b, GeomCollectionZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gc := make([]Geometry, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, gc[i], err = d.geometry(b)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, gc, nil
+}
+
+// IsEmpty reports whether collection has no members other than empty geometries.
+func (gc GeometryCollectionZM) IsEmpty() bool {
+	return isEmpty(gc)
+}
+
+func (gc GeometryCollectionZM) Kind() Kind {
+	return GeomCollectionZM
+}
+
+// Dimension returns highest dimension of members, 0 when collection has none.
+func (gc GeometryCollectionZM) Dimension() int {
+	return dimensionAll(gc)
+}
+
+func (gc GeometryCollectionZM) Envelope() Envelope {
+	return envelopeAll(gc)
+}
+
+func (gc GeometryCollectionZM) NumPoints() int {
+	return numPointsAll(gc)
+}
+
+func (gc GeometryCollectionZM) Equal(other Geometry) bool {
+	o, ok := other.(GeometryCollectionZM)
+	return ok && equalAll(gc, o)
+}
+
+// Centroid returns center of mass of members of highest dimension, empty point when it is empty.
+func (gc GeometryCollectionZM) Centroid() Point {
+	c := centroid{}
+	gc.addCentroid(&c)
+	return c.result()
+}
+
+func (gc GeometryCollectionZM) addCentroid(c *centroid) {
+	c.all(gc)
+}
+
+func (gc GeometryCollectionZM) addShape(s *shape) {
+	s.all(gc)
+}
+
+func (gc GeometryCollectionZM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, g := range gc {
+		size += g.ByteSize()
+	}
+	return size
+}
+
+func (gc GeometryCollectionZM) Write(buf *bytes.Buffer) {
+	buf.Write(gc.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (gc GeometryCollectionZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	gc.encode(e)
+	return e.release()
+}
+
+func (gc GeometryCollectionZM) encode(e *encoder) {
+	e.header(GeomCollectionZM)
+	e.count(len(gc))
+	for _, g := range gc {
+		e.geometry(g)
+	}
+}
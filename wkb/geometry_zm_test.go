@@ -0,0 +1,68 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var rawGeometryCollectionZM = []byte{
+	0x01, 0xbf, 0x0b, 0x00, 0x00, // header
+	0x02, 0x00, 0x00, 0x00, // numgeometry - 2
+	0x01, 0xb9, 0x0b, 0x00, 0x00, // geometry 1 - point
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+	0x01, 0x01, 0x00, 0x00, 0x00, // geometry 2 - 2D point
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+}
+
+func TestGeometryZM(t *testing.T) {
+	if g, err := New(rawPointZM); assert.NoError(t, err) {
+		assert.IsType(t, PointZM{}, g)
+	}
+
+	if g, err := New(rawMultiPointZM); assert.NoError(t, err) {
+		assert.IsType(t, MultiPointZM{}, g)
+	}
+
+	if g, err := New(rawLineStringZM); assert.NoError(t, err) {
+		assert.IsType(t, LineStringZM{}, g)
+	}
+
+	if g, err := New(rawMultiLineStringZM); assert.NoError(t, err) {
+		assert.IsType(t, MultiLineStringZM{}, g)
+	}
+
+	if g, err := New(rawPolygonZM); assert.NoError(t, err) {
+		assert.IsType(t, PolygonZM{}, g)
+	}
+
+	if g, err := New(rawMultiPolygonZM); assert.NoError(t, err) {
+		assert.IsType(t, MultiPolygonZM{}, g)
+	}
+
+	if g, err := New(rawGeometryCollectionZM); assert.NoError(t, err) {
+		assert.IsType(t, GeometryCollectionZM{}, g)
+	}
+}
+
+func TestGeometryCollectionZM(t *testing.T) {
+	if err := (&GeometryCollectionZM{}).Scan(rawGeometryCollection); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	gc := GeometryCollectionZM{}
+	if err := gc.Scan(rawGeometryCollectionZM); assert.NoError(t, err) {
+		assert.Equal(t, GeometryCollectionZM{
+			PointZM{4, 6, 1, 2},
+			Point{7, 10},
+		}, gc)
+	}
+
+	if raw, err := gc.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawGeometryCollectionZM, raw)
+	}
+}
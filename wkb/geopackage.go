@@ -0,0 +1,179 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"math"
+)
+
+const (
+	gpkgMagic0  = 'G'
+	gpkgMagic1  = 'P'
+	gpkgVersion = 0x00
+)
+
+const (
+	gpkgFlagByteOrder = 0x01
+	gpkgFlagEnvelope  = 0x0e
+	gpkgFlagXY        = 0x02
+	gpkgFlagEmpty     = 0x10
+	gpkgFlagExtended  = 0x20
+)
+
+const (
+	gpkgHeaderSize   = 4 + SRIDSize
+	gpkgEnvelopeSize = 4 * Float64Size
+)
+
+// GeoPackage is a geometry in GeoPackage binary format (GPB).
+// Envelope is written for all geometries except points of any dimension.
+type GeoPackage struct {
+	SRID int
+	Geometry
+}
+
+func (gp *GeoPackage) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return gp.UnmarshalBinary(b)
+}
+
+func (gp GeoPackage) Value() (driver.Value, error) {
+	return gp.MarshalBinary()
+}
+
+func (gp GeoPackage) MarshalBinary() ([]byte, error) {
+	if gp.Geometry == nil {
+		return nil, ErrUnsupportedValue
+	}
+	return gp.AppendWKB(make([]byte, 0, gp.ByteSize())), nil
+}
+
+func (gp *GeoPackage) UnmarshalBinary(b []byte) error {
+	_, tmp, err := ReadGeoPackage(b)
+	if err != nil {
+		return err
+	}
+
+	*gp = tmp
+	return nil
+}
+
+func ReadGeoPackage(b []byte) ([]byte, GeoPackage, error) {
+	gp := GeoPackage{}
+	if len(b) < gpkgHeaderSize {
+		return nil, gp, ErrInvalidStorage
+	}
+
+	if b[0] != gpkgMagic0 || b[1] != gpkgMagic1 {
+		return nil, gp, ErrInvalidStorage
+	}
+
+	flags := b[3]
+	if b[2] != gpkgVersion || flags&gpkgFlagExtended != 0 {
+		return nil, gp, ErrUnsupportedValue
+	}
+
+	dec := byteOrder(flags & gpkgFlagByteOrder)
+	_, srid := readUint32(b[4:], dec)
+
+	size, ok := gpkgEnvelopeSizes[(flags&gpkgFlagEnvelope)>>1]
+	if !ok {
+		return nil, gp, ErrUnsupportedValue
+	}
+
+	if len(b) < gpkgHeaderSize+size {
+		return nil, gp, ErrInvalidStorage
+	}
+
+	b, g, err := ReadGeometry(b[gpkgHeaderSize+size:])
+	if err != nil {
+		return nil, gp, err
+	}
+
+	gp.SRID = int(int32(srid))
+	gp.Geometry = g
+	return b, gp, nil
+}
+
+var gpkgEnvelopeSizes = map[byte]int{
+	0: 0,
+	1: 4 * Float64Size,
+	2: 6 * Float64Size,
+	3: 6 * Float64Size,
+	4: 8 * Float64Size,
+}
+
+func (gp GeoPackage) ByteSize() int {
+	return gpkgHeaderSize + gp.envelopeSize() + gp.Geometry.ByteSize()
+}
+
+// envelopeSize returns size of envelope reserved in header, which points do not have.
+func (gp GeoPackage) envelopeSize() int {
+	if gp.Geometry.Kind()%1000 == GeomPoint {
+		return 0
+	}
+	return gpkgEnvelopeSize
+}
+
+// Equal compares geometry with other, which must have same SRID when it is GeoPackage too.
+func (gp GeoPackage) Equal(other Geometry) bool {
+	if o, ok := other.(GeoPackage); ok {
+		if gp.SRID != o.SRID {
+			return false
+		}
+		other = o.Geometry
+	}
+	return gp.Geometry.Equal(other)
+}
+
+// Write encodes geometry as WKB following header with space reserved for envelope,
+// envelope is filled in from the encoded coordinates or dropped when there are none.
+func (gp GeoPackage) Write(buf *bytes.Buffer) {
+	buf.Write(gp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (gp GeoPackage) AppendWKB(dst []byte) []byte {
+	if gp.Geometry == nil {
+		return dst
+	}
+	e := appendEncoder(dst)
+	gp.encode(e)
+	return e.release()
+}
+
+func (gp GeoPackage) encode(w *encoder) {
+	start := len(w.buf)
+	envelope := gp.envelopeSize()
+
+	w.buf = append(w.buf, make([]byte, gpkgHeaderSize+envelope)...)
+	w.geometry(gp.Geometry)
+
+	b := w.buf[start:]
+	order := b[gpkgHeaderSize+envelope]
+	enc := byteOrder(order)
+
+	flags := order & gpkgFlagByteOrder
+	if envelope == 0 && gp.Geometry.IsEmpty() {
+		flags |= gpkgFlagEmpty
+	}
+	if envelope != 0 {
+		r := gp.Geometry.Envelope()
+		if r.IsEmpty() {
+			copy(b[gpkgHeaderSize:], b[gpkgHeaderSize+envelope:])
+			w.buf = w.buf[:len(w.buf)-envelope]
+			flags |= gpkgFlagEmpty
+		} else {
+			dst := b[gpkgHeaderSize:]
+			for i, f := range []float64{r.MinX, r.MaxX, r.MinY, r.MaxY} {
+				enc.PutUint64(dst[i*Float64Size:], math.Float64bits(f))
+			}
+			flags |= gpkgFlagXY
+		}
+	}
+
+	b[0], b[1], b[2], b[3] = gpkgMagic0, gpkgMagic1, gpkgVersion, flags
+	enc.PutUint32(b[4:], uint32(int32(gp.SRID)))
+}
@@ -0,0 +1,149 @@
+package wkb
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawGeoPackageLineString = []byte{
+		0x47, 0x50, 0x00, 0x03, // magic, version, flags - little endian, xy envelope
+		0xe6, 0x10, 0x00, 0x00, // srid - 4326
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40, // envelope
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x01, 0x02, 0x00, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+	}
+	rawGeoPackagePoint = []byte{
+		0x47, 0x50, 0x00, 0x00, // magic, version, flags - big endian, no envelope
+		0x00, 0x00, 0x10, 0xe6, // srid - 4326
+		0x01, 0x01, 0x00, 0x00, 0x00, // header
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+	}
+)
+
+func TestGeoPackage(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// header too short
+			ErrInvalidStorage,
+			[]byte{0x47, 0x50, 0x00, 0x01, 0xe6, 0x10},
+		},
+		{
+			// invalid magic
+			ErrInvalidStorage,
+			rawSpatialitePoint,
+		},
+		{
+			// unsupported version
+			ErrUnsupportedValue,
+			[]byte{0x47, 0x50, 0x01, 0x01, 0xe6, 0x10, 0x00, 0x00},
+		},
+		{
+			// extended geometry
+			ErrUnsupportedValue,
+			[]byte{0x47, 0x50, 0x00, 0x21, 0xe6, 0x10, 0x00, 0x00},
+		},
+		{
+			// invalid envelope
+			ErrUnsupportedValue,
+			[]byte{0x47, 0x50, 0x00, 0x0b, 0xe6, 0x10, 0x00, 0x00},
+		},
+		{
+			// envelope too short
+			ErrInvalidStorage,
+			rawGeoPackageLineString[:20],
+		},
+		{
+			// no geometry
+			ErrInvalidStorage,
+			rawGeoPackageLineString[:40],
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&GeoPackage{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	if err := (&GeoPackage{}).Scan(""); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+	}
+
+	gp := GeoPackage{}
+	if assert.NoError(t, gp.Scan(rawGeoPackageLineString)) {
+		assert.Equal(t, GeoPackage{4326, LineString{{10, 10}, {40, 40}}}, gp)
+	}
+
+	if raw, err := gp.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawGeoPackageLineString, raw)
+		assert.Len(t, raw, gp.ByteSize())
+	}
+
+	gp = GeoPackage{}
+	if assert.NoError(t, gp.Scan(rawGeoPackagePoint)) {
+		assert.Equal(t, GeoPackage{4326, Point{30, 10}}, gp)
+	}
+
+	if raw, err := gp.Value(); assert.NoError(t, err) {
+		_, tmp, err := ReadGeoPackage(raw.([]byte))
+		assert.NoError(t, err)
+		assert.Equal(t, gp, tmp)
+		assert.Len(t, raw, gp.ByteSize())
+	}
+
+	if raw, err := (GeoPackage{4326, MultiPoint{}}).Value(); assert.NoError(t, err) {
+		assert.Equal(t, []byte{
+			0x47, 0x50, 0x00, 0x11, // magic, version, flags - little endian, empty
+			0xe6, 0x10, 0x00, 0x00, // srid - 4326
+			0x01, 0x04, 0x00, 0x00, 0x00, // header
+			0x00, 0x00, 0x00, 0x00, // numpoints - 0
+		}, raw)
+	}
+
+	if raw, err := (GeoPackage{4326, EmptyPoint()}).Value(); assert.NoError(t, err) {
+		assert.Equal(t, []byte{
+			0x47, 0x50, 0x00, 0x11, // magic, version, flags - little endian, empty
+			0xe6, 0x10, 0x00, 0x00, // srid - 4326
+			0x01, 0x01, 0x00, 0x00, 0x00, // header
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x7f,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x7f,
+		}, raw)
+	}
+
+	// envelope ignores empty points
+	if raw, err := (GeoPackage{4326, MultiPoint{EmptyPoint(), {30, 10}}}).Value(); assert.NoError(t, err) {
+		_, tmp, err := ReadGeoPackage(raw.([]byte))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0x47, 0x50, 0x00, 0x03}, raw.([]byte)[:4])
+		assert.Equal(t, 30.0, math.Float64frombits(binary.LittleEndian.Uint64(raw.([]byte)[gpkgHeaderSize:])))
+		assert.True(t, tmp.Geometry.(MultiPoint)[0].IsEmpty())
+	}
+
+	// points of any dimension have no envelope
+	if raw, err := (GeoPackage{4326, PointZ{30, 10, 5}}).Value(); assert.NoError(t, err) {
+		_, tmp, err := ReadGeoPackage(raw.([]byte))
+		assert.NoError(t, err)
+		assert.Equal(t, GeoPackage{4326, PointZ{30, 10, 5}}, tmp)
+		assert.Equal(t, byte(0x01), raw.([]byte)[3])
+		assert.Len(t, raw, gpkgHeaderSize+HeaderSize+PointZSize)
+	}
+
+	_, err := GeoPackage{}.Value()
+	assert.ErrorIs(t, err, ErrUnsupportedValue)
+	assert.Empty(t, GeoPackage{}.AppendWKB(nil))
+}
@@ -0,0 +1,62 @@
+package wkb
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// ParseHex reads geometry from hex encoded WKB, optionally prefixed with \x as in PostgreSQL bytea output.
+func ParseHex(s string) (Geometry, error) {
+	b, err := decodeHex([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	return New(b)
+}
+
+// Hex returns geometry as upper case hex-encoded ISO WKB, or in own format of wrappers such as EWKB.
+func Hex(g Geometry) string {
+	return strings.ToUpper(hex.EncodeToString(Marshal(g)))
+}
+
+// scanBytes returns binary geometry from database value.
+// Strings are decoded as hex, byte slices only when they start with hex encoded
+// byte order or GeoPackage magic, which raw blobs never do.
+func scanBytes(src interface{}) ([]byte, error) {
+	switch src := src.(type) {
+	case []byte:
+		if isHex(src) {
+			return decodeHex(src)
+		}
+		return src, nil
+	case string:
+		return decodeHex([]byte(src))
+	default:
+		return nil, ErrInvalidStorage
+	}
+}
+
+func decodeHex(src []byte) ([]byte, error) {
+	if len(src) >= 2 && src[0] == '\\' && src[1] == 'x' {
+		src = src[2:]
+	}
+
+	b := make([]byte, hex.DecodedLen(len(src)))
+	if _, err := hex.Decode(b, src); err != nil {
+		return nil, ErrInvalidStorage
+	}
+	return b, nil
+}
+
+func isHex(b []byte) bool {
+	if len(b) < 2 {
+		return false
+	}
+
+	switch string(b[:2]) {
+	case "00", "01", "47", `\x`:
+		return true
+	default:
+		return false
+	}
+}
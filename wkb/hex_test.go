@@ -0,0 +1,67 @@
+package wkb
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const hexPoint = "01010000000000000000003E400000000000002440"
+
+func TestParseHex(t *testing.T) {
+	if g, err := ParseHex(hexPoint); assert.NoError(t, err) {
+		assert.Equal(t, Point{30, 10}, g)
+	}
+
+	if g, err := ParseHex(`\x` + strings.ToLower(hexPoint)); assert.NoError(t, err) {
+		assert.Equal(t, Point{30, 10}, g)
+	}
+
+	for _, s := range []string{"", "0101", "01010000000000000000003E40000000000000244", "zz"} {
+		if _, err := ParseHex(s); assert.Error(t, err) {
+			assert.ErrorIs(t, err, ErrInvalidStorage)
+		}
+	}
+}
+
+func TestHex(t *testing.T) {
+	assert.Equal(t, hexPoint, Hex(Point{30, 10}))
+
+	if g, err := ParseHex(Hex(MultiPolygonZ{{{{30, 10, 1}, {40, 40, 2}, {30, 10, 1}}}})); assert.NoError(t, err) {
+		assert.Equal(t, MultiPolygonZ{{{{30, 10, 1}, {40, 40, 2}, {30, 10, 1}}}}, g)
+	}
+}
+
+func TestScanHex(t *testing.T) {
+	for _, src := range []interface{}{hexPoint, []byte(hexPoint), `\x` + hexPoint, []byte(`\x` + hexPoint)} {
+		p := Point{}
+		if assert.NoError(t, p.Scan(src)) {
+			assert.Equal(t, Point{30, 10}, p)
+		}
+	}
+
+	if err := (&Point{}).Scan("not hex"); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+	}
+
+	if err := (&Point{}).Scan(42); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+	}
+
+	gp := GeoPackage{}
+	if assert.NoError(t, gp.Scan(strings.ToUpper(hex.EncodeToString(rawGeoPackagePoint)))) {
+		assert.Equal(t, GeoPackage{4326, Point{30, 10}}, gp)
+	}
+
+	s := Spatialite{}
+	if assert.NoError(t, s.Scan([]byte(hex.EncodeToString(rawSpatialitePoint)))) {
+		assert.Equal(t, Point{30, 10}, s.Geometry)
+	}
+
+	g := Geom{}
+	if assert.NoError(t, g.Scan(Hex(LineString{{30, 10}, {10, 30}}))) {
+		assert.Equal(t, LineString{{30, 10}, {10, 30}}, g.Geometry)
+	}
+}
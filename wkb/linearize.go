@@ -0,0 +1,162 @@
+package wkb
+
+import (
+	"math"
+)
+
+// DefaultSegments is number of segments per quarter circle used to linearize arcs when not specified.
+const DefaultSegments = 32
+
+type linearizer interface {
+	linearize(segments int) Geometry
+}
+
+// Linearize approximates curves with line segments, using given number of segments per quarter circle.
+// CircularString and CompoundCurve become LineString, CurvePolygon becomes Polygon,
+// MultiCurve becomes MultiLineString and MultiSurface becomes MultiPolygon.
+// Collections are linearized recursively and other geometries are returned unchanged.
+func Linearize(g Geometry, segments int) Geometry {
+	switch g := g.(type) {
+	case linearizer:
+		return g.linearize(segments)
+	case GeometryCollection:
+		return GeometryCollection(linearizeAll(g, segments))
+	case GeometryCollectionZ:
+		return GeometryCollectionZ(linearizeAll(g, segments))
+	case GeometryCollectionM:
+		return GeometryCollectionM(linearizeAll(g, segments))
+	case GeometryCollectionZM:
+		return GeometryCollectionZM(linearizeAll(g, segments))
+	default:
+		return g
+	}
+}
+
+func linearizeAll(gs []Geometry, segments int) []Geometry {
+	res := make([]Geometry, len(gs))
+	for i, g := range gs {
+		res[i] = Linearize(g, segments)
+	}
+	return res
+}
+
+// validArcs reports whether circular string of n points is empty or made of whole arcs sharing endpoints.
+func validArcs(n int) bool {
+	return n == 0 || n >= 3 && n%2 == 1
+}
+
+type arcPoint struct {
+	x, y, t float64
+}
+
+// arc approximates circular arc from p0 through p1 to p2 with points following p0 and ending with p2.
+// Position t of each point is 0..1 between p0 and p1 and 1..2 between p1 and p2,
+// used to interpolate other ordinates. Arc with p0 equal to p2 is a full circle.
+func arc(x0, y0, x1, y1, x2, y2 float64, segments int) []arcPoint {
+	if segments <= 0 {
+		segments = DefaultSegments
+	}
+
+	line := []arcPoint{{x1, y1, 1}, {x2, y2, 2}}
+	if x0 == x1 && y0 == y1 || x1 == x2 && y1 == y2 {
+		return line
+	}
+
+	c, ok := arcCircle(x0, y0, x1, y1, x2, y2)
+	if !ok {
+		return line
+	}
+
+	cx, cy, r, a0, dir, sweep := c.cx, c.cy, c.r, c.a0, c.dir, c.sweep
+	sweep1 := angle(dir * (math.Atan2(y1-cy, x1-cx) - a0))
+
+	n := int(math.Ceil(sweep / (math.Pi / 2) * float64(segments)))
+	pts := make([]arcPoint, 0, n)
+	for i := 1; i < n; i++ {
+		s := sweep * float64(i) / float64(n)
+		a := a0 + dir*s
+
+		t := s / sweep1
+		if s > sweep1 {
+			t = 1 + (s-sweep1)/(sweep-sweep1)
+		}
+		pts = append(pts, arcPoint{cx + r*math.Cos(a), cy + r*math.Sin(a), t})
+	}
+	return append(pts, arcPoint{x2, y2, 2})
+}
+
+// circle is circle of arc starting at angle a0 and sweeping counterclockwise when dir is 1, clockwise when -1.
+type circle struct {
+	cx, cy, r, a0, dir, sweep float64
+}
+
+// arcCircle finds circle of arc from p0 through p1 to p2, false when points are collinear.
+func arcCircle(x0, y0, x1, y1, x2, y2 float64) (circle, bool) {
+	full := x0 == x2 && y0 == y2
+	var cx, cy float64
+	if full {
+		cx, cy = (x0+x1)/2, (y0+y1)/2
+	} else {
+		d := 2 * (x0*(y1-y2) + x1*(y2-y0) + x2*(y0-y1))
+		if d == 0 {
+			return circle{}, false
+		}
+
+		s0, s1, s2 := x0*x0+y0*y0, x1*x1+y1*y1, x2*x2+y2*y2
+		cx = (s0*(y1-y2) + s1*(y2-y0) + s2*(y0-y1)) / d
+		cy = (s0*(x2-x1) + s1*(x0-x2) + s2*(x1-x0)) / d
+	}
+
+	dir := 1.0
+	if !full && (x1-x0)*(y2-y1)-(y1-y0)*(x2-x1) < 0 {
+		dir = -1
+	}
+
+	a0 := math.Atan2(y0-cy, x0-cx)
+	sweep := 2 * math.Pi
+	if !full {
+		sweep = angle(dir * (math.Atan2(y2-cy, x2-cx) - a0))
+	}
+	return circle{cx, cy, math.Hypot(x0-cx, y0-cy), a0, dir, sweep}, true
+}
+
+// arcEnvelope returns envelope of circular arcs through pts, including where arcs bulge past their control points.
+func arcEnvelope[T coord](pts []T) Envelope {
+	e := envelope(pts)
+	for i := 2; i < len(pts); i += 2 {
+		x0, y0 := pts[i-2].xy()
+		x1, y1 := pts[i-1].xy()
+		x2, y2 := pts[i].xy()
+		if x0 == x1 && y0 == y1 || x1 == x2 && y1 == y2 {
+			continue
+		}
+
+		c, ok := arcCircle(x0, y0, x1, y1, x2, y2)
+		if !ok {
+			continue
+		}
+		for q, d := range [][2]float64{{c.r, 0}, {0, c.r}, {-c.r, 0}, {0, -c.r}} {
+			if angle(c.dir*(float64(q)*math.Pi/2-c.a0)) <= c.sweep {
+				e = e.add(c.cx+d[0], c.cy+d[1])
+			}
+		}
+	}
+	return e
+}
+
+// angle normalizes angle to [0, 2π).
+func angle(a float64) float64 {
+	a = math.Mod(a, 2*math.Pi)
+	if a < 0 {
+		a += 2 * math.Pi
+	}
+	return a
+}
+
+// interpolate returns ordinate at arc position t given its values at p0, p1 and p2.
+func interpolate(t, v0, v1, v2 float64) float64 {
+	if t <= 1 {
+		return v0 + (v1-v0)*t
+	}
+	return v1 + (v2-v1)*(t-1)
+}
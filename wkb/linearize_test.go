@@ -0,0 +1,86 @@
+package wkb
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinearize(t *testing.T) {
+	// half circle from (0, 0) through (1, 1) to (2, 0), clockwise
+	ls := CircularString{{0, 0}, {1, 1}, {2, 0}}.Linearize(2)
+	if assert.Len(t, ls, 5) {
+		assert.Equal(t, Point{0, 0}, ls[0])
+		assert.InDelta(t, 1-math.Sqrt2/2, ls[1].X, 1e-9)
+		assert.InDelta(t, math.Sqrt2/2, ls[1].Y, 1e-9)
+		assert.InDelta(t, 1, ls[2].X, 1e-9)
+		assert.InDelta(t, 1, ls[2].Y, 1e-9)
+		assert.Equal(t, Point{2, 0}, ls[4])
+	}
+
+	for _, p := range (CircularString{{0, 0}, {1, 1}, {2, 0}}).Linearize(0) {
+		assert.InDelta(t, 1, math.Hypot(p.X-1, p.Y), 1e-9)
+	}
+	assert.Len(t, CircularString{{0, 0}, {1, 1}, {2, 0}}.Linearize(0), 2*DefaultSegments+1)
+
+	// counter-clockwise arc stays below chord
+	for _, p := range (CircularString{{0, 0}, {1, -1}, {2, 0}}).Linearize(4)[1:8] {
+		assert.Less(t, p.Y, 0.0)
+	}
+
+	// full circle
+	circle := CircularString{{0, 0}, {2, 0}, {0, 0}}.Linearize(1)
+	if assert.Len(t, circle, 5) {
+		assert.InDelta(t, 1, circle[1].X, 1e-9)
+		assert.InDelta(t, -1, circle[1].Y, 1e-9)
+		assert.Equal(t, circle[0], circle[4])
+	}
+
+	// collinear points
+	assert.Equal(t, LineString{{0, 0}, {1, 1}, {2, 2}}, CircularString{{0, 0}, {1, 1}, {2, 2}}.Linearize(8))
+
+	// points past last whole arc are joined with straight segments
+	assert.Equal(t, LineString{}, CircularString{}.Linearize(8))
+	assert.Equal(t, LineString{{0, 0}, {1, 1}}, CircularString{{0, 0}, {1, 1}}.Linearize(8))
+	tail := CircularString{{0, 0}, {1, 1}, {2, 0}, {3, 3}}.Linearize(2)
+	assert.Equal(t, Point{3, 3}, tail[len(tail)-1])
+	assert.Equal(t, CircularString{{0, 0}, {1, 1}, {2, 0}}.Linearize(2), tail[:len(tail)-1])
+
+	// z is interpolated along arc
+	lsz := CircularStringZ{{0, 0, 0}, {1, 1, 2}, {2, 0, 4}}.Linearize(2)
+	if assert.Len(t, lsz, 5) {
+		assert.InDelta(t, 1, lsz[1].Z, 1e-9)
+		assert.InDelta(t, 2, lsz[2].Z, 1e-9)
+		assert.InDelta(t, 3, lsz[3].Z, 1e-9)
+	}
+
+	// shared points of compound curve members are not repeated
+	cc := CompoundCurve{
+		CircularString{{0, 0}, {1, 1}, {2, 0}},
+		LineString{{2, 0}, {4, 0}},
+	}
+	lc := cc.Linearize(2)
+	assert.Len(t, lc, 6)
+	assert.Equal(t, Point{4, 0}, lc[5])
+
+	cp := CurvePolygon{CompoundCurve{
+		CircularString{{0, 0}, {1, 1}, {2, 0}},
+		LineString{{2, 0}, {0, 0}},
+	}}
+	assert.Equal(t, Polygon{{lc[0], lc[1], lc[2], lc[3], lc[4], {0, 0}}}, Linearize(cp, 2))
+
+	gc := GeometryCollection{
+		Point{1, 2},
+		MultiCurve{LineString{{0, 0}, {1, 0}}, CircularString{{0, 0}, {1, 1}, {2, 0}}},
+		MultiSurface{Polygon{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}, cp},
+	}
+	if res, ok := Linearize(gc, 2).(GeometryCollection); assert.True(t, ok) {
+		assert.Equal(t, Point{1, 2}, res[0])
+		assert.Equal(t, MultiLineString{{{0, 0}, {1, 0}}, lc[:5]}, res[1])
+		assert.Equal(t, Polygon{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}, res[2].(MultiPolygon)[0])
+		assert.Len(t, res[2].(MultiPolygon)[1][0], 6)
+	}
+
+	assert.Equal(t, LineString{{0, 0}, {1, 1}}, Linearize(LineString{{0, 0}, {1, 1}}, 2))
+}
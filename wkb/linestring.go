@@ -5,13 +5,30 @@ import (
 	"database/sql/driver"
 )
 
+// Scan decodes geometry into ls, reusing its backing arrays as UnmarshalTo does.
 func (ls *LineString) Scan(src interface{}) error {
-	b, ok := src.([]byte)
-	if !ok {
-		return ErrInvalidStorage
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
 	}
+	return ls.UnmarshalBinary(b)
+}
+
+func (ls LineString) Value() (driver.Value, error) {
+	return ls.MarshalBinary()
+}
 
-	_, tmp, err := ReadLineString(b)
+func (ls LineString) MarshalBinary() ([]byte, error) {
+	return ls.AppendWKB(make([]byte, 0, ls.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into ls, reusing its backing arrays as UnmarshalTo does.
+func (ls *LineString) UnmarshalBinary(b []byte) error {
+	return ls.unmarshal(newDecoder(b), b)
+}
+
+func (ls *LineString) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.lineString(b, reuse(d, b, *ls))
 	if err != nil {
 		return err
 	}
@@ -20,45 +37,111 @@ func (ls *LineString) Scan(src interface{}) error {
 	return nil
 }
 
-func (ls LineString) Value() (driver.Value, error) {
-	buf := bytes.NewBuffer(make([]byte, 0, ls.ByteSize()))
-	ls.Write(buf)
-	return buf.Bytes(), nil
-}
-
 func ReadLineString(b []byte) ([]byte, LineString, error) {
-	if len(b) < HeaderSize+CountSize {
-		return nil, nil, ErrInvalidStorage
-	}
+	return newDecoder(b).lineString(b, nil)
+}
 
-	b, dec, err := header(b, GeomLineString)
+func (d *decoder) lineString(b []byte, dst LineString) ([]byte, LineString, error) {
+	b, dec, err := d.header(b, GeomLineString)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	b, pts, err := readPoints(b, dec)
+	b, pts, err := d.points(b, dec, Points(dst))
 	if err != nil {
 		return nil, nil, err
 	}
 	return b, LineString(pts), err
 }
 
+func (ls LineString) IsEmpty() bool {
+	return len(ls) == 0
+}
+
+func (ls LineString) Kind() Kind {
+	return GeomLineString
+}
+
+func (ls LineString) Dimension() int {
+	return 1
+}
+
+func (ls LineString) Envelope() Envelope {
+	return envelope(ls)
+}
+
+func (ls LineString) NumPoints() int {
+	return len(ls)
+}
+
+func (ls LineString) Equal(other Geometry) bool {
+	o, ok := other.(LineString)
+	return ok && equalPoints(ls, o)
+}
+
+// Length returns length of line string.
+func (ls LineString) Length() float64 {
+	return lineLength(ls)
+}
+
+// Centroid returns center of mass of line string, empty point when it is empty.
+func (ls LineString) Centroid() Point {
+	c := centroid{}
+	ls.addCentroid(&c)
+	return c.result()
+}
+
+func (ls LineString) addCentroid(c *centroid) {
+	addLine(c, ls)
+}
+
+func (ls LineString) addShape(s *shape) {
+	s.line(ls)
+}
+
 func (ls LineString) ByteSize() int {
 	return HeaderSize + Points(ls).byteSize()
 }
 
 func (ls LineString) Write(buf *bytes.Buffer) {
-	writeHeader(buf, GeomLineString)
-	Points(ls).write(buf)
+	buf.Write(ls.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ls LineString) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ls.encode(e)
+	return e.release()
+}
+
+func (ls LineString) encode(e *encoder) {
+	e.header(GeomLineString)
+	Points(ls).encode(e)
 }
 
+// Scan decodes geometry into mls, reusing its backing arrays as UnmarshalTo does.
 func (mls *MultiLineString) Scan(src interface{}) error {
-	b, ok := src.([]byte)
-	if !ok {
-		return ErrInvalidStorage
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
 	}
+	return mls.UnmarshalBinary(b)
+}
+
+func (mls MultiLineString) Value() (driver.Value, error) {
+	return mls.MarshalBinary()
+}
+
+func (mls MultiLineString) MarshalBinary() ([]byte, error) {
+	return mls.AppendWKB(make([]byte, 0, mls.ByteSize())), nil
+}
 
-	_, tmp, err := ReadMultiLineString(b)
+// UnmarshalBinary decodes geometry into mls, reusing its backing arrays as UnmarshalTo does.
+func (mls *MultiLineString) UnmarshalBinary(b []byte) error {
+	return mls.unmarshal(newDecoder(b), b)
+}
+
+func (mls *MultiLineString) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiLineString(b, reuse(d, b, *mls))
 	if err != nil {
 		return err
 	}
@@ -67,34 +150,92 @@ func (mls *MultiLineString) Scan(src interface{}) error {
 	return nil
 }
 
-func (mls MultiLineString) Value() (driver.Value, error) {
-	buf := bytes.NewBuffer(make([]byte, 0, mls.ByteSize()))
-	mls.Write(buf)
-	return buf.Bytes(), nil
+func ReadMultiLineString(b []byte) ([]byte, MultiLineString, error) {
+	return newDecoder(b).multiLineString(b, nil)
 }
 
-func ReadMultiLineString(b []byte) ([]byte, MultiLineString, error) {
-	if len(b) < HeaderSize+CountSize {
-		return nil, nil, ErrInvalidStorage
+func (d *decoder) multiLineString(b []byte, dst MultiLineString) ([]byte, MultiLineString, error) {
+	b, dec, err := d.header(b, GeomMultiLineString)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	b, dec, err := header(b, GeomMultiLineString)
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	b, n := readCount(b, dec)
-
-	mls := make([]LineString, n)
+	mls := grow(dst, n)
+	d.push("")
 	for i := 0; i < n; i++ {
-		b, mls[i], err = ReadLineString(b)
+		d.at(i)
+		b, mls[i], err = d.lineString(b, mls[i])
 		if err != nil {
 			return nil, nil, err
 		}
 	}
+	d.pop()
 	return b, mls, err
 }
 
+func (mls MultiLineString) IsEmpty() bool {
+	for _, ls := range mls {
+		if !ls.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mls MultiLineString) Kind() Kind {
+	return GeomMultiLineString
+}
+
+func (mls MultiLineString) Dimension() int {
+	return 1
+}
+
+func (mls MultiLineString) Envelope() Envelope {
+	return envelopeAll(mls)
+}
+
+func (mls MultiLineString) NumPoints() int {
+	return numPointsAll(mls)
+}
+
+func (mls MultiLineString) Equal(other Geometry) bool {
+	o, ok := other.(MultiLineString)
+	return ok && equalAll(mls, o)
+}
+
+// Length returns total length of line strings.
+func (mls MultiLineString) Length() float64 {
+	length := 0.0
+	for _, ls := range mls {
+		length += ls.Length()
+	}
+	return length
+}
+
+// Centroid returns center of mass of line strings, empty point when it is empty.
+func (mls MultiLineString) Centroid() Point {
+	c := centroid{}
+	mls.addCentroid(&c)
+	return c.result()
+}
+
+func (mls MultiLineString) addCentroid(c *centroid) {
+	for _, ls := range mls {
+		ls.addCentroid(c)
+	}
+}
+
+func (mls MultiLineString) addShape(s *shape) {
+	for _, ls := range mls {
+		s.line(ls)
+	}
+}
+
 func (mls MultiLineString) ByteSize() int {
 	size := HeaderSize + CountSize
 	for _, ls := range mls {
@@ -104,9 +245,19 @@ func (mls MultiLineString) ByteSize() int {
 }
 
 func (mls MultiLineString) Write(buf *bytes.Buffer) {
-	writeHeader(buf, GeomMultiLineString)
-	writeCount(buf, len(mls))
+	buf.Write(mls.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mls MultiLineString) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mls.encode(e)
+	return e.release()
+}
+
+func (mls MultiLineString) encode(e *encoder) {
+	e.header(GeomMultiLineString)
+	e.count(len(mls))
 	for _, ls := range mls {
-		ls.Write(buf)
+		ls.encode(e)
 	}
 }
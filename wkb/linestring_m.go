@@ -0,0 +1,263 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// Scan decodes geometry into ls, reusing its backing arrays as UnmarshalTo does.
+func (ls *LineStringM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ls.UnmarshalBinary(b)
+}
+
+func (ls LineStringM) Value() (driver.Value, error) {
+	return ls.MarshalBinary()
+}
+
+func (ls LineStringM) MarshalBinary() ([]byte, error) {
+	return ls.AppendWKB(make([]byte, 0, ls.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into ls, reusing its backing arrays as UnmarshalTo does.
+func (ls *LineStringM) UnmarshalBinary(b []byte) error {
+	return ls.unmarshal(newDecoder(b), b)
+}
+
+func (ls *LineStringM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.lineStringM(b, reuse(d, b, *ls))
+	if err != nil {
+		return err
+	}
+
+	*ls = tmp
+	return nil
+}
+
+func ReadLineStringM(b []byte) ([]byte, LineStringM, error) {
+	return newDecoder(b).lineStringM(b, nil)
+}
+
+func (d *decoder) lineStringM(b []byte, dst LineStringM) ([]byte, LineStringM, error) {
+	b, dec, err := d.header(b, GeomLineStringM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, pts, err := d.pointsM(b, dec, PointsM(dst))
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, LineStringM(pts), err
+}
+
+func (ls LineStringM) IsEmpty() bool {
+	return len(ls) == 0
+}
+
+func (ls LineStringM) Kind() Kind {
+	return GeomLineStringM
+}
+
+func (ls LineStringM) Dimension() int {
+	return 1
+}
+
+func (ls LineStringM) Envelope() Envelope {
+	return envelope(ls)
+}
+
+func (ls LineStringM) NumPoints() int {
+	return len(ls)
+}
+
+func (ls LineStringM) Equal(other Geometry) bool {
+	o, ok := other.(LineStringM)
+	return ok && equalPoints(ls, o)
+}
+
+// Length returns length of line string.
+func (ls LineStringM) Length() float64 {
+	return lineLength(ls)
+}
+
+// Centroid returns center of mass of line string, empty point when it is empty.
+func (ls LineStringM) Centroid() Point {
+	c := centroid{}
+	ls.addCentroid(&c)
+	return c.result()
+}
+
+func (ls LineStringM) addCentroid(c *centroid) {
+	addLine(c, ls)
+}
+
+func (ls LineStringM) addShape(s *shape) {
+	s.line(LineString(project(ls)))
+}
+
+func (ls LineStringM) ByteSize() int {
+	return HeaderSize + PointsM(ls).byteSize()
+}
+
+func (ls LineStringM) Write(buf *bytes.Buffer) {
+	buf.Write(ls.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ls LineStringM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ls.encode(e)
+	return e.release()
+}
+
+func (ls LineStringM) encode(e *encoder) {
+	e.header(GeomLineStringM)
+	PointsM(ls).encode(e)
+}
+
+// Scan decodes geometry into mls, reusing its backing arrays as UnmarshalTo does.
+func (mls *MultiLineStringM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mls.UnmarshalBinary(b)
+}
+
+func (mls MultiLineStringM) Value() (driver.Value, error) {
+	return mls.MarshalBinary()
+}
+
+func (mls MultiLineStringM) MarshalBinary() ([]byte, error) {
+	return mls.AppendWKB(make([]byte, 0, mls.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mls, reusing its backing arrays as UnmarshalTo does.
+func (mls *MultiLineStringM) UnmarshalBinary(b []byte) error {
+	return mls.unmarshal(newDecoder(b), b)
+}
+
+func (mls *MultiLineStringM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiLineStringM(b, reuse(d, b, *mls))
+	if err != nil {
+		return err
+	}
+
+	*mls = tmp
+	return nil
+}
+
+func ReadMultiLineStringM(b []byte) ([]byte, MultiLineStringM, error) {
+	return newDecoder(b).multiLineStringM(b, nil)
+}
+
+func (d *decoder) multiLineStringM(b []byte, dst MultiLineStringM) ([]byte, MultiLineStringM, error) {
+	b, dec, err := d.header(b, GeomMultiLineStringM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mls := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, mls[i], err = d.lineStringM(b, mls[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+	return b, mls, err
+}
+
+func (mls MultiLineStringM) IsEmpty() bool {
+	for _, ls := range mls {
+		if !ls.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mls MultiLineStringM) Kind() Kind {
+	return GeomMultiLineStringM
+}
+
+func (mls MultiLineStringM) Dimension() int {
+	return 1
+}
+
+func (mls MultiLineStringM) Envelope() Envelope {
+	return envelopeAll(mls)
+}
+
+func (mls MultiLineStringM) NumPoints() int {
+	return numPointsAll(mls)
+}
+
+func (mls MultiLineStringM) Equal(other Geometry) bool {
+	o, ok := other.(MultiLineStringM)
+	return ok && equalAll(mls, o)
+}
+
+// Length returns total length of line strings.
+func (mls MultiLineStringM) Length() float64 {
+	length := 0.0
+	for _, ls := range mls {
+		length += ls.Length()
+	}
+	return length
+}
+
+// Centroid returns center of mass of line strings, empty point when it is empty.
+func (mls MultiLineStringM) Centroid() Point {
+	c := centroid{}
+	mls.addCentroid(&c)
+	return c.result()
+}
+
+func (mls MultiLineStringM) addCentroid(c *centroid) {
+	for _, ls := range mls {
+		ls.addCentroid(c)
+	}
+}
+
+func (mls MultiLineStringM) addShape(s *shape) {
+	for _, ls := range mls {
+		ls.addShape(s)
+	}
+}
+
+func (mls MultiLineStringM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, ls := range mls {
+		size += ls.ByteSize()
+	}
+	return size
+}
+
+func (mls MultiLineStringM) Write(buf *bytes.Buffer) {
+	buf.Write(mls.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mls MultiLineStringM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mls.encode(e)
+	return e.release()
+}
+
+func (mls MultiLineStringM) encode(e *encoder) {
+	e.header(GeomMultiLineStringM)
+	e.count(len(mls))
+	for _, ls := range mls {
+		ls.encode(e)
+	}
+}
@@ -0,0 +1,103 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawLineStringM = []byte{
+		0x01, 0xd2, 0x07, 0x00, 0x00, // header
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40, // point 1
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40, // point 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40, // point 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x40,
+	}
+	rawMultiLineStringM = []byte{
+		0x01, 0xd5, 0x07, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numlinestring - 2
+		0x01, 0xd2, 0x07, 0x00, 0x00, // linestring 1
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x01, 0xd2, 0x07, 0x00, 0x00, // linestring 2
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+)
+
+func TestLineStringM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// 2D linestring
+			ErrUnsupportedValue,
+			rawLineString,
+		},
+		{
+			// no element payload
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0xd2, 0x07, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numpoints - 1
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		ls := LineStringM{}
+		if err := ls.Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	ls := LineStringM{}
+	if assert.NoError(t, ls.Scan(rawLineStringM)) {
+		assert.Equal(t, LineStringM{{30, 10, 1}, {10, 30, 2}, {40, 40, 3}}, ls)
+	}
+
+	if raw, err := ls.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawLineStringM, raw)
+	}
+}
+
+func TestMultiLineStringM(t *testing.T) {
+	if err := (&MultiLineStringM{}).Scan(rawMultiLineString); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	mls := MultiLineStringM{}
+	if assert.NoError(t, mls.Scan(rawMultiLineStringM)) {
+		assert.Equal(t, MultiLineStringM{
+			LineStringM{{10, 10, 1}, {20, 20, 2}},
+			LineStringM{{40, 40, 3}, {30, 30, 4}, {40, 20, 5}},
+		}, mls)
+	}
+
+	if raw, err := mls.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiLineStringM, raw)
+	}
+}
@@ -1,6 +1,7 @@
 package wkb
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -74,12 +75,12 @@ func TestLineString(t *testing.T) {
 	for _, e := range invalid {
 		ls := LineString{}
 		if err := ls.Scan(e.b); assert.Error(t, err) {
-			assert.Exactly(t, e.err, err)
+			assert.ErrorIs(t, err, e.err)
 		}
 	}
 
 	if err := (&LineString{}).Scan(""); assert.Error(t, err) {
-		assert.Exactly(t, ErrInvalidStorage, err)
+		assert.ErrorIs(t, err, ErrInvalidStorage)
 	}
 
 	ls := LineString{}
@@ -144,12 +145,12 @@ func TestMultiLineString(t *testing.T) {
 	for _, e := range invalid {
 		mls := MultiLineString{}
 		if err := mls.Scan(e.b); assert.Error(t, err) {
-			assert.Exactly(t, e.err, err)
+			assert.ErrorIs(t, err, e.err)
 		}
 	}
 
 	if err := (&MultiLineString{}).Scan(""); assert.Error(t, err) {
-		assert.Exactly(t, ErrInvalidStorage, err)
+		assert.ErrorIs(t, err, ErrInvalidStorage)
 	}
 
 	mls := MultiLineString{}
@@ -164,3 +165,31 @@ func TestMultiLineString(t *testing.T) {
 		assert.Equal(t, rawMultiLineString, raw)
 	}
 }
+
+// coastline returns closed line of n vertices.
+func coastline(n int) LineString {
+	ls := make(LineString, n)
+	for i := range ls {
+		a := 2 * math.Pi * float64(i) / float64(n-1)
+		ls[i] = Point{math.Cos(a) * (10 + math.Sin(50*a)), math.Sin(a) * (10 + math.Sin(50*a))}
+	}
+	ls[n-1] = ls[0]
+	return ls
+}
+
+func BenchmarkReadLineString(b *testing.B) {
+	ls := coastline(1000000)
+	for _, order := range []ByteOrder{LittleEndian, BigEndian} {
+		name := map[ByteOrder]string{LittleEndian: "ndr", BigEndian: "xdr"}[order]
+		raw := Marshal(ls, WithByteOrder(order))
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(raw)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := ReadLineString(raw); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// Scan decodes geometry into ls, reusing its backing arrays as UnmarshalTo does.
+func (ls *LineStringZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ls.UnmarshalBinary(b)
+}
+
+func (ls LineStringZ) Value() (driver.Value, error) {
+	return ls.MarshalBinary()
+}
+
+func (ls LineStringZ) MarshalBinary() ([]byte, error) {
+	return ls.AppendWKB(make([]byte, 0, ls.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into ls, reusing its backing arrays as UnmarshalTo does.
+func (ls *LineStringZ) UnmarshalBinary(b []byte) error {
+	return ls.unmarshal(newDecoder(b), b)
+}
+
+func (ls *LineStringZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.lineStringZ(b, reuse(d, b, *ls))
+	if err != nil {
+		return err
+	}
+
+	*ls = tmp
+	return nil
+}
+
+func ReadLineStringZ(b []byte) ([]byte, LineStringZ, error) {
+	return newDecoder(b).lineStringZ(b, nil)
+}
+
+func (d *decoder) lineStringZ(b []byte, dst LineStringZ) ([]byte, LineStringZ, error) {
+	b, dec, err := d.header(b, GeomLineStringZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, pts, err := d.pointsZ(b, dec, PointsZ(dst))
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, LineStringZ(pts), err
+}
+
+func (ls LineStringZ) IsEmpty() bool {
+	return len(ls) == 0
+}
+
+func (ls LineStringZ) Kind() Kind {
+	return GeomLineStringZ
+}
+
+func (ls LineStringZ) Dimension() int {
+	return 1
+}
+
+func (ls LineStringZ) Envelope() Envelope {
+	return envelope(ls)
+}
+
+func (ls LineStringZ) NumPoints() int {
+	return len(ls)
+}
+
+func (ls LineStringZ) Equal(other Geometry) bool {
+	o, ok := other.(LineStringZ)
+	return ok && equalPoints(ls, o)
+}
+
+// Length returns length of line string.
+func (ls LineStringZ) Length() float64 {
+	return lineLength(ls)
+}
+
+// Centroid returns center of mass of line string, empty point when it is empty.
+func (ls LineStringZ) Centroid() Point {
+	c := centroid{}
+	ls.addCentroid(&c)
+	return c.result()
+}
+
+func (ls LineStringZ) addCentroid(c *centroid) {
+	addLine(c, ls)
+}
+
+func (ls LineStringZ) addShape(s *shape) {
+	s.line(LineString(project(ls)))
+}
+
+func (ls LineStringZ) ByteSize() int {
+	return HeaderSize + PointsZ(ls).byteSize()
+}
+
+func (ls LineStringZ) Write(buf *bytes.Buffer) {
+	buf.Write(ls.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ls LineStringZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ls.encode(e)
+	return e.release()
+}
+
+func (ls LineStringZ) encode(e *encoder) {
+	e.header(GeomLineStringZ)
+	PointsZ(ls).encode(e)
+}
+
+// Scan decodes geometry into mls, reusing its backing arrays as UnmarshalTo does.
+func (mls *MultiLineStringZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mls.UnmarshalBinary(b)
+}
+
+func (mls MultiLineStringZ) Value() (driver.Value, error) {
+	return mls.MarshalBinary()
+}
+
+func (mls MultiLineStringZ) MarshalBinary() ([]byte, error) {
+	return mls.AppendWKB(make([]byte, 0, mls.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mls, reusing its backing arrays as UnmarshalTo does.
+func (mls *MultiLineStringZ) UnmarshalBinary(b []byte) error {
+	return mls.unmarshal(newDecoder(b), b)
+}
+
+func (mls *MultiLineStringZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiLineStringZ(b, reuse(d, b, *mls))
+	if err != nil {
+		return err
+	}
+
+	*mls = tmp
+	return nil
+}
+
+func ReadMultiLineStringZ(b []byte) ([]byte, MultiLineStringZ, error) {
+	return newDecoder(b).multiLineStringZ(b, nil)
+}
+
+func (d *decoder) multiLineStringZ(b []byte, dst MultiLineStringZ) ([]byte, MultiLineStringZ, error) {
+	b, dec, err := d.header(b, GeomMultiLineStringZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mls := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, mls[i], err = d.lineStringZ(b, mls[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+	return b, mls, err
+}
+
+func (mls MultiLineStringZ) IsEmpty() bool {
+	for _, ls := range mls {
+		if !ls.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mls MultiLineStringZ) Kind() Kind {
+	return GeomMultiLineStringZ
+}
+
+func (mls MultiLineStringZ) Dimension() int {
+	return 1
+}
+
+func (mls MultiLineStringZ) Envelope() Envelope {
+	return envelopeAll(mls)
+}
+
+func (mls MultiLineStringZ) NumPoints() int {
+	return numPointsAll(mls)
+}
+
+func (mls MultiLineStringZ) Equal(other Geometry) bool {
+	o, ok := other.(MultiLineStringZ)
+	return ok && equalAll(mls, o)
+}
+
+// Length returns total length of line strings.
+func (mls MultiLineStringZ) Length() float64 {
+	length := 0.0
+	for _, ls := range mls {
+		length += ls.Length()
+	}
+	return length
+}
+
+// Centroid returns center of mass of line strings, empty point when it is empty.
+func (mls MultiLineStringZ) Centroid() Point {
+	c := centroid{}
+	mls.addCentroid(&c)
+	return c.result()
+}
+
+func (mls MultiLineStringZ) addCentroid(c *centroid) {
+	for _, ls := range mls {
+		ls.addCentroid(c)
+	}
+}
+
+func (mls MultiLineStringZ) addShape(s *shape) {
+	for _, ls := range mls {
+		ls.addShape(s)
+	}
+}
+
+func (mls MultiLineStringZ) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, ls := range mls {
+		size += ls.ByteSize()
+	}
+	return size
+}
+
+func (mls MultiLineStringZ) Write(buf *bytes.Buffer) {
+	buf.Write(mls.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mls MultiLineStringZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mls.encode(e)
+	return e.release()
+}
+
+func (mls MultiLineStringZ) encode(e *encoder) {
+	e.header(GeomMultiLineStringZ)
+	e.count(len(mls))
+	for _, ls := range mls {
+		ls.encode(e)
+	}
+}
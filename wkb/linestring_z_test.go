@@ -0,0 +1,103 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawLineStringZ = []byte{
+		0x01, 0xea, 0x03, 0x00, 0x00, // header
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40, // point 1
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40, // point 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40, // point 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x40,
+	}
+	rawMultiLineStringZ = []byte{
+		0x01, 0xed, 0x03, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numlinestring - 2
+		0x01, 0xea, 0x03, 0x00, 0x00, // linestring 1
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x01, 0xea, 0x03, 0x00, 0x00, // linestring 2
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+)
+
+func TestLineStringZ(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// 2D linestring
+			ErrUnsupportedValue,
+			rawLineString,
+		},
+		{
+			// no element payload
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0xea, 0x03, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numpoints - 1
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		ls := LineStringZ{}
+		if err := ls.Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	ls := LineStringZ{}
+	if assert.NoError(t, ls.Scan(rawLineStringZ)) {
+		assert.Equal(t, LineStringZ{{30, 10, 1}, {10, 30, 2}, {40, 40, 3}}, ls)
+	}
+
+	if raw, err := ls.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawLineStringZ, raw)
+	}
+}
+
+func TestMultiLineStringZ(t *testing.T) {
+	if err := (&MultiLineStringZ{}).Scan(rawMultiLineString); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	mls := MultiLineStringZ{}
+	if assert.NoError(t, mls.Scan(rawMultiLineStringZ)) {
+		assert.Equal(t, MultiLineStringZ{
+			LineStringZ{{10, 10, 1}, {20, 20, 2}},
+			LineStringZ{{40, 40, 3}, {30, 30, 4}, {40, 20, 5}},
+		}, mls)
+	}
+
+	if raw, err := mls.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiLineStringZ, raw)
+	}
+}
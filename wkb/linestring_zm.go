@@ -0,0 +1,263 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// Scan decodes geometry into ls, reusing its backing arrays as UnmarshalTo does.
+func (ls *LineStringZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ls.UnmarshalBinary(b)
+}
+
+func (ls LineStringZM) Value() (driver.Value, error) {
+	return ls.MarshalBinary()
+}
+
+func (ls LineStringZM) MarshalBinary() ([]byte, error) {
+	return ls.AppendWKB(make([]byte, 0, ls.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into ls, reusing its backing arrays as UnmarshalTo does.
+func (ls *LineStringZM) UnmarshalBinary(b []byte) error {
+	return ls.unmarshal(newDecoder(b), b)
+}
+
+func (ls *LineStringZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.lineStringZM(b, reuse(d, b, *ls))
+	if err != nil {
+		return err
+	}
+
+	*ls = tmp
+	return nil
+}
+
+func ReadLineStringZM(b []byte) ([]byte, LineStringZM, error) {
+	return newDecoder(b).lineStringZM(b, nil)
+}
+
+func (d *decoder) lineStringZM(b []byte, dst LineStringZM) ([]byte, LineStringZM, error) {
+	b, dec, err := d.header(b, GeomLineStringZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, pts, err := d.pointsZM(b, dec, PointsZM(dst))
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, LineStringZM(pts), err
+}
+
+func (ls LineStringZM) IsEmpty() bool {
+	return len(ls) == 0
+}
+
+func (ls LineStringZM) Kind() Kind {
+	return GeomLineStringZM
+}
+
+func (ls LineStringZM) Dimension() int {
+	return 1
+}
+
+func (ls LineStringZM) Envelope() Envelope {
+	return envelope(ls)
+}
+
+func (ls LineStringZM) NumPoints() int {
+	return len(ls)
+}
+
+func (ls LineStringZM) Equal(other Geometry) bool {
+	o, ok := other.(LineStringZM)
+	return ok && equalPoints(ls, o)
+}
+
+// Length returns length of line string.
+func (ls LineStringZM) Length() float64 {
+	return lineLength(ls)
+}
+
+// Centroid returns center of mass of line string, empty point when it is empty.
+func (ls LineStringZM) Centroid() Point {
+	c := centroid{}
+	ls.addCentroid(&c)
+	return c.result()
+}
+
+func (ls LineStringZM) addCentroid(c *centroid) {
+	addLine(c, ls)
+}
+
+func (ls LineStringZM) addShape(s *shape) {
+	s.line(LineString(project(ls)))
+}
+
+func (ls LineStringZM) ByteSize() int {
+	return HeaderSize + PointsZM(ls).byteSize()
+}
+
+func (ls LineStringZM) Write(buf *bytes.Buffer) {
+	buf.Write(ls.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ls LineStringZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ls.encode(e)
+	return e.release()
+}
+
+func (ls LineStringZM) encode(e *encoder) {
+	e.header(GeomLineStringZM)
+	PointsZM(ls).encode(e)
+}
+
+// Scan decodes geometry into mls, reusing its backing arrays as UnmarshalTo does.
+func (mls *MultiLineStringZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mls.UnmarshalBinary(b)
+}
+
+func (mls MultiLineStringZM) Value() (driver.Value, error) {
+	return mls.MarshalBinary()
+}
+
+func (mls MultiLineStringZM) MarshalBinary() ([]byte, error) {
+	return mls.AppendWKB(make([]byte, 0, mls.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mls, reusing its backing arrays as UnmarshalTo does.
+func (mls *MultiLineStringZM) UnmarshalBinary(b []byte) error {
+	return mls.unmarshal(newDecoder(b), b)
+}
+
+func (mls *MultiLineStringZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiLineStringZM(b, reuse(d, b, *mls))
+	if err != nil {
+		return err
+	}
+
+	*mls = tmp
+	return nil
+}
+
+func ReadMultiLineStringZM(b []byte) ([]byte, MultiLineStringZM, error) {
+	return newDecoder(b).multiLineStringZM(b, nil)
+}
+
+func (d *decoder) multiLineStringZM(b []byte, dst MultiLineStringZM) ([]byte, MultiLineStringZM, error) {
+	b, dec, err := d.header(b, GeomMultiLineStringZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mls := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, mls[i], err = d.lineStringZM(b, mls[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+	return b, mls, err
+}
+
+func (mls MultiLineStringZM) IsEmpty() bool {
+	for _, ls := range mls {
+		if !ls.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mls MultiLineStringZM) Kind() Kind {
+	return GeomMultiLineStringZM
+}
+
+func (mls MultiLineStringZM) Dimension() int {
+	return 1
+}
+
+func (mls MultiLineStringZM) Envelope() Envelope {
+	return envelopeAll(mls)
+}
+
+func (mls MultiLineStringZM) NumPoints() int {
+	return numPointsAll(mls)
+}
+
+func (mls MultiLineStringZM) Equal(other Geometry) bool {
+	o, ok := other.(MultiLineStringZM)
+	return ok && equalAll(mls, o)
+}
+
+// Length returns total length of line strings.
+func (mls MultiLineStringZM) Length() float64 {
+	length := 0.0
+	for _, ls := range mls {
+		length += ls.Length()
+	}
+	return length
+}
+
+// Centroid returns center of mass of line strings, empty point when it is empty.
+func (mls MultiLineStringZM) Centroid() Point {
+	c := centroid{}
+	mls.addCentroid(&c)
+	return c.result()
+}
+
+func (mls MultiLineStringZM) addCentroid(c *centroid) {
+	for _, ls := range mls {
+		ls.addCentroid(c)
+	}
+}
+
+func (mls MultiLineStringZM) addShape(s *shape) {
+	for _, ls := range mls {
+		ls.addShape(s)
+	}
+}
+
+func (mls MultiLineStringZM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, ls := range mls {
+		size += ls.ByteSize()
+	}
+	return size
+}
+
+func (mls MultiLineStringZM) Write(buf *bytes.Buffer) {
+	buf.Write(mls.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mls MultiLineStringZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mls.encode(e)
+	return e.release()
+}
+
+func (mls MultiLineStringZM) encode(e *encoder) {
+	e.header(GeomMultiLineStringZM)
+	e.count(len(mls))
+	for _, ls := range mls {
+		ls.encode(e)
+	}
+}
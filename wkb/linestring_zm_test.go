@@ -0,0 +1,111 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawLineStringZM = []byte{
+		0x01, 0xba, 0x0b, 0x00, 0x00, // header
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40, // point 1
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40, // point 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40, // point 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x40,
+	}
+	rawMultiLineStringZM = []byte{
+		0x01, 0xbd, 0x0b, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numlinestring - 2
+		0x01, 0xba, 0x0b, 0x00, 0x00, // linestring 1
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x01, 0xba, 0x0b, 0x00, 0x00, // linestring 2
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+	}
+)
+
+func TestLineStringZM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// 2D linestring
+			ErrUnsupportedValue,
+			rawLineString,
+		},
+		{
+			// no element payload
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0xba, 0x0b, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numpoints - 1
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		ls := LineStringZM{}
+		if err := ls.Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	ls := LineStringZM{}
+	if assert.NoError(t, ls.Scan(rawLineStringZM)) {
+		assert.Equal(t, LineStringZM{{30, 10, 1, 4}, {10, 30, 2, 5}, {40, 40, 3, 6}}, ls)
+	}
+
+	if raw, err := ls.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawLineStringZM, raw)
+	}
+}
+
+func TestMultiLineStringZM(t *testing.T) {
+	if err := (&MultiLineStringZM{}).Scan(rawMultiLineString); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	mls := MultiLineStringZM{}
+	if assert.NoError(t, mls.Scan(rawMultiLineStringZM)) {
+		assert.Equal(t, MultiLineStringZM{
+			LineStringZM{{10, 10, 1, 0}, {20, 20, 2, 0}},
+			LineStringZM{{40, 40, 3, 1}, {30, 30, 4, 1}, {40, 20, 5, 1}},
+		}, mls)
+	}
+
+	if raw, err := mls.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiLineStringZM, raw)
+	}
+}
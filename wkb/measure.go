@@ -0,0 +1,146 @@
+package wkb
+
+import (
+	"math"
+)
+
+// Measures are planar, computed from X and Y only.
+
+type centroider interface {
+	addCentroid(c *centroid)
+}
+
+// Centroid returns center of mass of geometry, like ST_Centroid.
+// Only components of highest dimension with non-zero area or length contribute,
+// so centroid of collection with polygon and point is centroid of polygon.
+// Curves are linearized with DefaultSegments. Empty geometry has empty centroid.
+func Centroid(g Geometry) Point {
+	c := centroid{}
+	c.geometry(g)
+	return c.result()
+}
+
+// centroid accumulates centroids of components weighted by area, length and count.
+type centroid struct {
+	area, areaX, areaY       float64
+	length, lengthX, lengthY float64
+	points, pointX, pointY   float64
+}
+
+func (c *centroid) geometry(g Geometry) {
+	switch g := g.(type) {
+	case centroider:
+		g.addCentroid(c)
+	case View:
+		if d, err := g.Decode(); err == nil {
+			c.geometry(d)
+		}
+	case EWKB:
+		c.geometry(g.Geometry)
+	case Spatialite:
+		c.geometry(g.Geometry)
+	case GeoPackage:
+		c.geometry(g.Geometry)
+	case Geom:
+		c.geometry(g.Geometry)
+	}
+}
+
+func (c *centroid) all(gs []Geometry) {
+	for _, g := range gs {
+		c.geometry(g)
+	}
+}
+
+func (c *centroid) point(x, y float64) {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return
+	}
+	c.points++
+	c.pointX += x
+	c.pointY += y
+}
+
+func (c *centroid) result() Point {
+	switch {
+	case c.area != 0:
+		return Point{c.areaX / c.area, c.areaY / c.area}
+	case c.length != 0:
+		return Point{c.lengthX / c.length, c.lengthY / c.length}
+	case c.points != 0:
+		return Point{c.pointX / c.points, c.pointY / c.points}
+	default:
+		return EmptyPoint()
+	}
+}
+
+// addLine adds segments of line, or its first point when it has no length.
+func addLine[T coord](c *centroid, pts []T) {
+	length := 0.0
+	for i := 1; i < len(pts); i++ {
+		x0, y0 := pts[i-1].xy()
+		x1, y1 := pts[i].xy()
+		l := math.Hypot(x1-x0, y1-y0)
+		length += l
+		c.lengthX += l * (x0 + x1) / 2
+		c.lengthY += l * (y0 + y1) / 2
+	}
+	c.length += length
+
+	if length == 0 && len(pts) > 0 {
+		c.point(pts[0].xy())
+	}
+}
+
+// addRing adds area of ring, subtracting it for holes, and its boundary in case polygon has no area.
+func addRing[T coord](c *centroid, r []T, hole bool) {
+	a, x, y := ringCentroid(r)
+	if hole {
+		a = -a
+	}
+	c.area += a
+	c.areaX += a * x
+	c.areaY += a * y
+	addLine(c, r)
+}
+
+// ringCentroid returns unsigned area and centroid of ring.
+// Coordinates are taken relative to first point to limit loss of precision.
+func ringCentroid[T coord](r []T) (area, x, y float64) {
+	if len(r) < 3 {
+		return 0, 0, 0
+	}
+
+	ox, oy := r[0].xy()
+	a2, sx, sy := 0.0, 0.0, 0.0
+	for i := 1; i < len(r)-1; i++ {
+		x0, y0 := r[i].xy()
+		x1, y1 := r[i+1].xy()
+		x0, y0, x1, y1 = x0-ox, y0-oy, x1-ox, y1-oy
+
+		cross := x0*y1 - x1*y0
+		a2 += cross
+		sx += (x0 + x1) * cross
+		sy += (y0 + y1) * cross
+	}
+	if a2 == 0 {
+		return 0, 0, 0
+	}
+	return math.Abs(a2) / 2, ox + sx/(3*a2), oy + sy/(3*a2)
+}
+
+// ringArea returns unsigned area of ring.
+func ringArea[T coord](r []T) float64 {
+	a, _, _ := ringCentroid(r)
+	return a
+}
+
+func lineLength[T coord](pts []T) float64 {
+	length := 0.0
+	for i := 1; i < len(pts); i++ {
+		x0, y0 := pts[i-1].xy()
+		x1, y1 := pts[i].xy()
+		length += math.Hypot(x1-x0, y1-y0)
+	}
+	return length
+}
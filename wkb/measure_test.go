@@ -0,0 +1,83 @@
+package wkb
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArea(t *testing.T) {
+	square := LinearRing{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	hole := LinearRing{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}
+
+	assert.Equal(t, 100.0, Polygon{square}.Area())
+	assert.Equal(t, 96.0, Polygon{square, hole}.Area())
+	assert.Equal(t, 48.0, Polygon{square, hole}.Perimeter())
+	assert.Equal(t, 0.0, Polygon{}.Area())
+
+	// orientation of rings does not matter
+	reversed := LinearRing{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}
+	assert.Equal(t, 100.0, Polygon{reversed}.Area())
+
+	mp := MultiPolygon{{square, hole}, {{{20, 0}, {21, 0}, {21, 1}, {20, 0}}}}
+	assert.Equal(t, 96.5, mp.Area())
+	assert.InDelta(t, 48+2+math.Sqrt2, mp.Perimeter(), 1e-12)
+
+	assert.Equal(t, 0.5, Triangle{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}.Area())
+	assert.Equal(t, 6.0, PolygonZ{{{0, 0, 5}, {3, 0, 9}, {3, 2, 1}, {0, 2, 0}, {0, 0, 5}}}.Area())
+}
+
+func TestLength(t *testing.T) {
+	assert.Equal(t, 5.0, LineString{{0, 0}, {3, 4}}.Length())
+	assert.Equal(t, 0.0, LineString{}.Length())
+	assert.Equal(t, 7.0, MultiLineString{{{0, 0}, {3, 4}}, {{1, 1}, {1, 2}, {1, 3}}}.Length())
+	assert.Equal(t, 5.0, LineStringZM{{0, 0, 100, 1}, {3, 4, -100, 2}}.Length())
+}
+
+func TestCentroid(t *testing.T) {
+	square := LinearRing{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	hole := LinearRing{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}
+	c := (100*5.0 - 4*3) / 96
+
+	valid := []struct {
+		g        Geometry
+		expected Point
+	}{
+		{Point{1, 2}, Point{1, 2}},
+		{MultiPoint{{0, 0}, EmptyPoint(), {2, 4}}, Point{1, 2}},
+		{LineString{{0, 0}, {3, 4}}, Point{1.5, 2}},
+		{MultiLineString{{{0, 0}, {2, 0}}, {{10, 0}, {10, 6}}}, Point{7.75, 2.25}},
+		{Polygon{square}, Point{5, 5}},
+		{Polygon{square, hole}, Point{c, c}},
+		{MultiPolygon{{square}, {{{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 0}}}}, Point{15, 5}},
+		{Triangle{{{0, 0}, {3, 0}, {0, 3}, {0, 0}}}, Point{1, 1}},
+		{PolygonZ{{{0, 0, 1}, {2, 0, 1}, {2, 2, 1}, {0, 2, 1}, {0, 0, 1}}}, Point{1, 1}},
+		// highest dimension wins
+		{GeometryCollection{Polygon{square}, Point{100, 100}, LineString{{50, 50}, {60, 60}}}, Point{5, 5}},
+		{GeometryCollection{Point{100, 100}, LineString{{0, 0}, {2, 0}}}, Point{1, 0}},
+		// degenerate components fall back to lower dimension
+		{Polygon{{{0, 0}, {4, 0}, {0, 0}}}, Point{2, 0}},
+		{LineString{{3, 3}, {3, 3}}, Point{3, 3}},
+		{EWKB{4326, LineString{{0, 0}, {0, 2}}}, Point{0, 1}},
+		{View(Marshal(Polygon{square})), Point{5, 5}},
+	}
+
+	for _, e := range valid {
+		actual := Centroid(e.g)
+		assert.InDelta(t, e.expected.X, actual.X, 1e-12, "%#v", e.g)
+		assert.InDelta(t, e.expected.Y, actual.Y, 1e-12, "%#v", e.g)
+	}
+
+	assert.Equal(t, Point{c, c}, Polygon{square, hole}.Centroid())
+	assert.Equal(t, Point{1.5, 2}, LineStringM{{0, 0, 1}, {3, 4, 2}}.Centroid())
+
+	// centroid of semicircle arc lies 2/π from its center
+	arc := CircularString{{-1, 0}, {0, 1}, {1, 0}}.Centroid()
+	assert.InDelta(t, 0, arc.X, 1e-9)
+	assert.InDelta(t, 2/math.Pi, arc.Y, 1e-3)
+
+	for _, g := range []Geometry{EmptyPoint(), MultiPoint{}, LineString{}, Polygon{}, GeometryCollection{}, MultiSurface{}} {
+		assert.True(t, Centroid(g).IsEmpty(), "%#v", g)
+	}
+}
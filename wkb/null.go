@@ -0,0 +1,45 @@
+package wkb
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Null is geometry that may be NULL, in the style of sql.NullString.
+// Geometry is valid when Valid is true, for example Null[Point] scans nullable point column.
+type Null[T Geometry] struct {
+	Geometry T
+	Valid    bool
+}
+
+func (n *Null[T]) Scan(src interface{}) error {
+	var zero T
+	n.Geometry, n.Valid = zero, false
+	if src == nil {
+		return nil
+	}
+
+	s, ok := interface{}(&n.Geometry).(sql.Scanner)
+	if !ok {
+		return ErrUnsupportedValue
+	}
+
+	if err := s.Scan(src); err != nil {
+		n.Geometry = zero
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	if v, ok := interface{}(n.Geometry).(driver.Valuer); ok {
+		return v.Value()
+	}
+	return Marshal(n.Geometry), nil
+}
@@ -0,0 +1,50 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNull(t *testing.T) {
+	p := Null[Point]{Point{1, 2}, true}
+	if assert.NoError(t, p.Scan(nil)) {
+		assert.Equal(t, Null[Point]{}, p)
+	}
+
+	if raw, err := p.Value(); assert.NoError(t, err) {
+		assert.Nil(t, raw)
+	}
+
+	if assert.NoError(t, p.Scan(rawPoint)) {
+		assert.Equal(t, Null[Point]{Point{30, 10}, true}, p)
+	}
+
+	if raw, err := p.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawPoint, raw)
+	}
+
+	if err := p.Scan(rawLineString); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+		assert.Equal(t, Null[Point]{}, p)
+	}
+
+	mp := Null[MultiPolygon]{}
+	if assert.NoError(t, mp.Scan(rawMultiPolygon)) {
+		assert.True(t, mp.Valid)
+		assert.Len(t, mp.Geometry, 2)
+	}
+
+	if assert.NoError(t, mp.Scan(nil)) {
+		assert.False(t, mp.Valid)
+		assert.Nil(t, mp.Geometry)
+	}
+
+	s := Null[Spatialite]{}
+	if assert.NoError(t, s.Scan(rawSpatialitePoint)) {
+		assert.True(t, s.Valid)
+		if raw, err := s.Value(); assert.NoError(t, err) {
+			assert.Equal(t, rawSpatialitePoint, raw)
+		}
+	}
+}
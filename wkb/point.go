@@ -4,29 +4,33 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/binary"
+	"math"
 )
 
 type Point struct {
 	X, Y float64
 }
 
-func (p Point) Equal(other Point) bool {
-	return p.X == other.X && p.Y == other.Y
+// EmptyPoint returns empty point, which has NaN coordinates.
+func EmptyPoint() Point {
+	nan := math.NaN()
+	return Point{nan, nan}
 }
 
 func (p Point) Value() (driver.Value, error) {
-	buf := bytes.NewBuffer(make([]byte, 0, p.ByteSize()))
-	p.Write(buf)
-	return buf.Bytes(), nil
+	return p.MarshalBinary()
 }
 
-func (p *Point) Scan(src interface{}) error {
-	b, ok := src.([]byte)
-	if !ok {
-		return ErrInvalidStorage
-	}
+func (p Point) MarshalBinary() ([]byte, error) {
+	return p.AppendWKB(make([]byte, 0, p.ByteSize())), nil
+}
 
-	_, tmp, err := ReadPoint(b)
+func (p *Point) UnmarshalBinary(b []byte) error {
+	return p.unmarshal(newDecoder(b), b)
+}
+
+func (p *Point) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.point(b)
 	if err != nil {
 		return err
 	}
@@ -35,39 +39,126 @@ func (p *Point) Scan(src interface{}) error {
 	return nil
 }
 
+func (p *Point) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return p.UnmarshalBinary(b)
+}
+
+// IsEmpty reports whether point is empty, which is encoded with NaN coordinates.
+func (p Point) IsEmpty() bool {
+	return math.IsNaN(p.X) && math.IsNaN(p.Y)
+}
+
+func (p Point) Kind() Kind {
+	return GeomPoint
+}
+
+func (p Point) Dimension() int {
+	return 0
+}
+
+func (p Point) Envelope() Envelope {
+	return EmptyEnvelope().add(p.X, p.Y)
+}
+
+func (p Point) NumPoints() int {
+	if p.IsEmpty() {
+		return 0
+	}
+	return 1
+}
+
+// Equal reports whether other is Point with equal coordinates, empty points are equal.
+func (p Point) Equal(other Geometry) bool {
+	o, ok := other.(Point)
+	return ok && equalPoint(p, o)
+}
+
+// Centroid returns center of mass of point, empty point when it is empty.
+func (p Point) Centroid() Point {
+	c := centroid{}
+	p.addCentroid(&c)
+	return c.result()
+}
+
+func (p Point) addCentroid(c *centroid) {
+	c.point(p.X, p.Y)
+}
+
+func (p Point) addShape(s *shape) {
+	s.point(p)
+}
+
+func (p Point) xy() (x, y float64) {
+	return p.X, p.Y
+}
+
 func (p Point) ByteSize() int {
 	return HeaderSize + PointSize
 }
 
 func (p Point) Write(buf *bytes.Buffer) {
-	writeHeader(buf, GeomPoint)
-	writeFloat64(buf, p.X)
-	writeFloat64(buf, p.Y)
+	buf.Write(p.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (p Point) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	p.encode(e)
+	return e.release()
+}
+
+func (p Point) encode(e *encoder) {
+	e.header(GeomPoint)
+	e.float64(p.X)
+	e.float64(p.Y)
 }
 
 func ReadPoint(b []byte) ([]byte, Point, error) {
-	p := Point{}
-	if len(b) < HeaderSize+PointSize {
-		return nil, p, ErrInvalidStorage
-	}
+	return newDecoder(b).point(b)
+}
 
-	b, dec, err := header(b, GeomPoint)
+func (d *decoder) point(b []byte) ([]byte, Point, error) {
+	p := Point{}
+	b, dec, err := d.header(b, GeomPoint)
 	if err != nil {
 		return nil, p, err
 	}
 
-	b, p.X = readFloat64(b, dec)
-	b, p.Y = readFloat64(b, dec)
+	if len(b) < PointSize {
+		return nil, p, d.fail(b, ErrInvalidStorage)
+	}
+
+	b, p = readPoint(b, dec)
 	return b, p, nil
 }
 
+// Scan decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
 func (mp *MultiPoint) Scan(src interface{}) error {
-	b, ok := src.([]byte)
-	if !ok {
-		return ErrInvalidStorage
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
 	}
+	return mp.UnmarshalBinary(b)
+}
 
-	_, tmp, err := ReadMultiPoint(b)
+func (mp MultiPoint) Value() (driver.Value, error) {
+	return mp.MarshalBinary()
+}
+
+func (mp MultiPoint) MarshalBinary() ([]byte, error) {
+	return mp.AppendWKB(make([]byte, 0, mp.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPoint) UnmarshalBinary(b []byte) error {
+	return mp.unmarshal(newDecoder(b), b)
+}
+
+func (mp *MultiPoint) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiPoint(b, reuse(d, b, *mp))
 	if err != nil {
 		return err
 	}
@@ -76,44 +167,103 @@ func (mp *MultiPoint) Scan(src interface{}) error {
 	return nil
 }
 
-func (mp MultiPoint) Value() (driver.Value, error) {
-	buf := bytes.NewBuffer(make([]byte, 0, mp.ByteSize()))
-	mp.Write(buf)
-	return buf.Bytes(), nil
+func ReadMultiPoint(b []byte) ([]byte, MultiPoint, error) {
+	return newDecoder(b).multiPoint(b, nil)
 }
 
-func ReadMultiPoint(b []byte) ([]byte, MultiPoint, error) {
-	if len(b) < HeaderSize+CountSize {
-		return nil, nil, ErrInvalidStorage
+func (d *decoder) multiPoint(b []byte, dst MultiPoint) ([]byte, MultiPoint, error) {
+	b, dec, err := d.header(b, GeomMultiPoint)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	b, dec, err := header(b, GeomMultiPoint)
+	b, n, err := d.count(b, dec, HeaderSize+PointSize)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	b, n := readCount(b, dec)
-
-	mp := make([]Point, n)
+	mp := grow(dst, n)
+	d.push("")
 	for i := 0; i < n; i++ {
-		b, mp[i], err = ReadPoint(b)
+		d.at(i)
+		b, mp[i], err = d.point(b)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
+	d.pop()
 
 	return b, mp, nil
 }
 
+func (mp MultiPoint) IsEmpty() bool {
+	for _, p := range mp {
+		if !p.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mp MultiPoint) Kind() Kind {
+	return GeomMultiPoint
+}
+
+func (mp MultiPoint) Dimension() int {
+	return 0
+}
+
+func (mp MultiPoint) Envelope() Envelope {
+	return envelope(mp)
+}
+
+func (mp MultiPoint) NumPoints() int {
+	return numPoints(mp)
+}
+
+func (mp MultiPoint) Equal(other Geometry) bool {
+	o, ok := other.(MultiPoint)
+	return ok && equalPoints(mp, o)
+}
+
+// Centroid returns center of mass of points, empty point when it is empty.
+func (mp MultiPoint) Centroid() Point {
+	c := centroid{}
+	mp.addCentroid(&c)
+	return c.result()
+}
+
+func (mp MultiPoint) addCentroid(c *centroid) {
+	for _, p := range mp {
+		c.point(p.X, p.Y)
+	}
+}
+
+func (mp MultiPoint) addShape(s *shape) {
+	for _, p := range mp {
+		s.point(p)
+	}
+}
+
 func (mp MultiPoint) ByteSize() int {
-	return HeaderSize + Points(mp).byteSize()
+	return HeaderSize + CountSize + len(mp)*(HeaderSize+PointSize)
 }
 
 func (mp MultiPoint) Write(buf *bytes.Buffer) {
-	writeHeader(buf, GeomMultiPoint)
-	writeCount(buf, len(mp))
+	buf.Write(mp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mp MultiPoint) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mp.encode(e)
+	return e.release()
+}
+
+func (mp MultiPoint) encode(e *encoder) {
+	e.header(GeomMultiPoint)
+	e.count(len(mp))
 	for _, p := range mp {
-		p.Write(buf)
+		p.encode(e)
 	}
 }
 
@@ -124,18 +274,21 @@ func readPoint(b []byte, dec binary.ByteOrder) ([]byte, Point) {
 	return b, p
 }
 
-func readPoints(b []byte, dec binary.ByteOrder) ([]byte, Points, error) {
-	b, n := readCount(b, dec)
-
-	if len(b) < PointSize*n {
-		return nil, nil, ErrInvalidStorage
+func (d *decoder) points(b []byte, dec binary.ByteOrder, dst Points) ([]byte, Points, error) {
+	b, n, err := d.count(b, dec, 0)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	p := make([]Point, n)
-	for i := 0; i < n; i++ {
-		b, p[i] = readPoint(b, dec)
+	if m := len(b) / PointSize; n > m {
+		d.push("point")
+		d.at(m)
+		return nil, nil, d.fail(b[m*PointSize:], ErrInvalidStorage)
 	}
 
+	p := grow(dst, n)
+	b = readCoords(b, dec, p)
+
 	return b, p, nil
 }
 
@@ -143,10 +296,10 @@ func (pts Points) byteSize() int {
 	return CountSize + len(pts)*PointSize
 }
 
-func (pts Points) write(buf *bytes.Buffer) {
-	writeCount(buf, len(pts))
+func (pts Points) encode(e *encoder) {
+	e.count(len(pts))
 	for _, p := range pts {
-		writeFloat64(buf, p.X)
-		writeFloat64(buf, p.Y)
+		e.float64(p.X)
+		e.float64(p.Y)
 	}
 }
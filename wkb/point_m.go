@@ -0,0 +1,308 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"math"
+)
+
+type PointM struct {
+	X, Y, M float64
+}
+
+// EmptyPointM returns empty point, which has NaN coordinates.
+func EmptyPointM() PointM {
+	nan := math.NaN()
+	return PointM{nan, nan, nan}
+}
+
+func (p PointM) Value() (driver.Value, error) {
+	return p.MarshalBinary()
+}
+
+func (p PointM) MarshalBinary() ([]byte, error) {
+	return p.AppendWKB(make([]byte, 0, p.ByteSize())), nil
+}
+
+func (p *PointM) UnmarshalBinary(b []byte) error {
+	return p.unmarshal(newDecoder(b), b)
+}
+
+func (p *PointM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.pointM(b)
+	if err != nil {
+		return err
+	}
+
+	*p = tmp
+	return nil
+}
+
+func (p *PointM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return p.UnmarshalBinary(b)
+}
+
+// IsEmpty reports whether point is empty, which is encoded with NaN coordinates.
+func (p PointM) IsEmpty() bool {
+	return math.IsNaN(p.X) && math.IsNaN(p.Y)
+}
+
+func (p PointM) Kind() Kind {
+	return GeomPointM
+}
+
+func (p PointM) Dimension() int {
+	return 0
+}
+
+func (p PointM) Envelope() Envelope {
+	return EmptyEnvelope().add(p.X, p.Y)
+}
+
+func (p PointM) NumPoints() int {
+	if p.IsEmpty() {
+		return 0
+	}
+	return 1
+}
+
+// Equal reports whether other is PointM with equal coordinates, empty points are equal.
+func (p PointM) Equal(other Geometry) bool {
+	o, ok := other.(PointM)
+	return ok && equalPoint(p, o)
+}
+
+// Centroid returns center of mass of point, empty point when it is empty.
+func (p PointM) Centroid() Point {
+	c := centroid{}
+	p.addCentroid(&c)
+	return c.result()
+}
+
+func (p PointM) addCentroid(c *centroid) {
+	c.point(p.X, p.Y)
+}
+
+func (p PointM) addShape(s *shape) {
+	s.point(Point{p.X, p.Y})
+}
+
+func (p PointM) xy() (x, y float64) {
+	return p.X, p.Y
+}
+
+func (p PointM) ByteSize() int {
+	return HeaderSize + PointMSize
+}
+
+func (p PointM) Write(buf *bytes.Buffer) {
+	buf.Write(p.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (p PointM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	p.encode(e)
+	return e.release()
+}
+
+func (p PointM) encode(e *encoder) {
+	e.header(GeomPointM)
+	e.float64(p.X)
+	e.float64(p.Y)
+	e.float64(p.M)
+}
+
+func ReadPointM(b []byte) ([]byte, PointM, error) {
+	return newDecoder(b).pointM(b)
+}
+
+func (d *decoder) pointM(b []byte) ([]byte, PointM, error) {
+	p := PointM{}
+	b, dec, err := d.header(b, GeomPointM)
+	if err != nil {
+		return nil, p, err
+	}
+
+	if len(b) < PointMSize {
+		return nil, p, d.fail(b, ErrInvalidStorage)
+	}
+
+	b, p = readPointM(b, dec)
+	return b, p, nil
+}
+
+// Scan decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPointM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mp.UnmarshalBinary(b)
+}
+
+func (mp MultiPointM) Value() (driver.Value, error) {
+	return mp.MarshalBinary()
+}
+
+func (mp MultiPointM) MarshalBinary() ([]byte, error) {
+	return mp.AppendWKB(make([]byte, 0, mp.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPointM) UnmarshalBinary(b []byte) error {
+	return mp.unmarshal(newDecoder(b), b)
+}
+
+func (mp *MultiPointM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiPointM(b, reuse(d, b, *mp))
+	if err != nil {
+		return err
+	}
+
+	*mp = tmp
+	return nil
+}
+
+func ReadMultiPointM(b []byte) ([]byte, MultiPointM, error) {
+	return newDecoder(b).multiPointM(b, nil)
+}
+
+func (d *decoder) multiPointM(b []byte, dst MultiPointM) ([]byte, MultiPointM, error) {
+	b, dec, err := d.header(b, GeomMultiPointM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+PointMSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mp := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, mp[i], err = d.pointM(b)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, mp, nil
+}
+
+func (mp MultiPointM) IsEmpty() bool {
+	for _, p := range mp {
+		if !p.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mp MultiPointM) Kind() Kind {
+	return GeomMultiPointM
+}
+
+func (mp MultiPointM) Dimension() int {
+	return 0
+}
+
+func (mp MultiPointM) Envelope() Envelope {
+	return envelope(mp)
+}
+
+func (mp MultiPointM) NumPoints() int {
+	return numPoints(mp)
+}
+
+func (mp MultiPointM) Equal(other Geometry) bool {
+	o, ok := other.(MultiPointM)
+	return ok && equalPoints(mp, o)
+}
+
+// Centroid returns center of mass of points, empty point when it is empty.
+func (mp MultiPointM) Centroid() Point {
+	c := centroid{}
+	mp.addCentroid(&c)
+	return c.result()
+}
+
+func (mp MultiPointM) addCentroid(c *centroid) {
+	for _, p := range mp {
+		c.point(p.X, p.Y)
+	}
+}
+
+func (mp MultiPointM) addShape(s *shape) {
+	for _, p := range mp {
+		s.point(Point{p.X, p.Y})
+	}
+}
+
+func (mp MultiPointM) ByteSize() int {
+	return HeaderSize + CountSize + len(mp)*(HeaderSize+PointMSize)
+}
+
+func (mp MultiPointM) Write(buf *bytes.Buffer) {
+	buf.Write(mp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mp MultiPointM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mp.encode(e)
+	return e.release()
+}
+
+func (mp MultiPointM) encode(e *encoder) {
+	e.header(GeomMultiPointM)
+	e.count(len(mp))
+	for _, p := range mp {
+		p.encode(e)
+	}
+}
+
+func readPointM(b []byte, dec binary.ByteOrder) ([]byte, PointM) {
+	p := PointM{}
+	b, p.X = readFloat64(b, dec)
+	b, p.Y = readFloat64(b, dec)
+	b, p.M = readFloat64(b, dec)
+	return b, p
+}
+
+func (d *decoder) pointsM(b []byte, dec binary.ByteOrder, dst PointsM) ([]byte, PointsM, error) {
+	b, n, err := d.count(b, dec, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if m := len(b) / PointMSize; n > m {
+		d.push("point")
+		d.at(m)
+		return nil, nil, d.fail(b[m*PointMSize:], ErrInvalidStorage)
+	}
+
+	p := grow(dst, n)
+	b = readCoords(b, dec, p)
+
+	return b, p, nil
+}
+
+func (pts PointsM) byteSize() int {
+	return CountSize + len(pts)*PointMSize
+}
+
+func (pts PointsM) encode(e *encoder) {
+	e.count(len(pts))
+	for _, p := range pts {
+		e.float64(p.X)
+		e.float64(p.Y)
+		e.float64(p.M)
+	}
+}
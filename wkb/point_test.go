@@ -59,12 +59,12 @@ func TestPoint(t *testing.T) {
 	for expected, b := range invalid {
 		p := Point{}
 		if err := p.Scan(b); assert.Error(t, err) {
-			assert.Exactly(t, expected, err, "Expected point <%s> to fail", hex.EncodeToString(b))
+			assert.ErrorIs(t, err, expected, "Expected point <%s> to fail", hex.EncodeToString(b))
 		}
 	}
 
 	if err := (&Point{}).Scan(""); assert.Error(t, err) {
-		assert.Exactly(t, ErrInvalidStorage, err)
+		assert.ErrorIs(t, err, ErrInvalidStorage)
 	}
 
 	p := Point{}
@@ -133,12 +133,12 @@ func TestMultiPoint(t *testing.T) {
 	for _, e := range invalid {
 		mp := MultiPoint{}
 		if err := mp.Scan(e.b); assert.Error(t, err) {
-			assert.Exactly(t, e.err, err)
+			assert.ErrorIs(t, err, e.err)
 		}
 	}
 
 	if err := (&MultiPoint{}).Scan(""); assert.Error(t, err) {
-		assert.Exactly(t, ErrInvalidStorage, err)
+		assert.ErrorIs(t, err, ErrInvalidStorage)
 	}
 
 	mp := MultiPoint{}
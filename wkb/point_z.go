@@ -0,0 +1,308 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"math"
+)
+
+type PointZ struct {
+	X, Y, Z float64
+}
+
+// EmptyPointZ returns empty point, which has NaN coordinates.
+func EmptyPointZ() PointZ {
+	nan := math.NaN()
+	return PointZ{nan, nan, nan}
+}
+
+func (p PointZ) Value() (driver.Value, error) {
+	return p.MarshalBinary()
+}
+
+func (p PointZ) MarshalBinary() ([]byte, error) {
+	return p.AppendWKB(make([]byte, 0, p.ByteSize())), nil
+}
+
+func (p *PointZ) UnmarshalBinary(b []byte) error {
+	return p.unmarshal(newDecoder(b), b)
+}
+
+func (p *PointZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.pointZ(b)
+	if err != nil {
+		return err
+	}
+
+	*p = tmp
+	return nil
+}
+
+func (p *PointZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return p.UnmarshalBinary(b)
+}
+
+// IsEmpty reports whether point is empty, which is encoded with NaN coordinates.
+func (p PointZ) IsEmpty() bool {
+	return math.IsNaN(p.X) && math.IsNaN(p.Y)
+}
+
+func (p PointZ) Kind() Kind {
+	return GeomPointZ
+}
+
+func (p PointZ) Dimension() int {
+	return 0
+}
+
+func (p PointZ) Envelope() Envelope {
+	return EmptyEnvelope().add(p.X, p.Y)
+}
+
+func (p PointZ) NumPoints() int {
+	if p.IsEmpty() {
+		return 0
+	}
+	return 1
+}
+
+// Equal reports whether other is PointZ with equal coordinates, empty points are equal.
+func (p PointZ) Equal(other Geometry) bool {
+	o, ok := other.(PointZ)
+	return ok && equalPoint(p, o)
+}
+
+// Centroid returns center of mass of point, empty point when it is empty.
+func (p PointZ) Centroid() Point {
+	c := centroid{}
+	p.addCentroid(&c)
+	return c.result()
+}
+
+func (p PointZ) addCentroid(c *centroid) {
+	c.point(p.X, p.Y)
+}
+
+func (p PointZ) addShape(s *shape) {
+	s.point(Point{p.X, p.Y})
+}
+
+func (p PointZ) xy() (x, y float64) {
+	return p.X, p.Y
+}
+
+func (p PointZ) ByteSize() int {
+	return HeaderSize + PointZSize
+}
+
+func (p PointZ) Write(buf *bytes.Buffer) {
+	buf.Write(p.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (p PointZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	p.encode(e)
+	return e.release()
+}
+
+func (p PointZ) encode(e *encoder) {
+	e.header(GeomPointZ)
+	e.float64(p.X)
+	e.float64(p.Y)
+	e.float64(p.Z)
+}
+
+func ReadPointZ(b []byte) ([]byte, PointZ, error) {
+	return newDecoder(b).pointZ(b)
+}
+
+func (d *decoder) pointZ(b []byte) ([]byte, PointZ, error) {
+	p := PointZ{}
+	b, dec, err := d.header(b, GeomPointZ)
+	if err != nil {
+		return nil, p, err
+	}
+
+	if len(b) < PointZSize {
+		return nil, p, d.fail(b, ErrInvalidStorage)
+	}
+
+	b, p = readPointZ(b, dec)
+	return b, p, nil
+}
+
+// Scan decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPointZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mp.UnmarshalBinary(b)
+}
+
+func (mp MultiPointZ) Value() (driver.Value, error) {
+	return mp.MarshalBinary()
+}
+
+func (mp MultiPointZ) MarshalBinary() ([]byte, error) {
+	return mp.AppendWKB(make([]byte, 0, mp.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPointZ) UnmarshalBinary(b []byte) error {
+	return mp.unmarshal(newDecoder(b), b)
+}
+
+func (mp *MultiPointZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiPointZ(b, reuse(d, b, *mp))
+	if err != nil {
+		return err
+	}
+
+	*mp = tmp
+	return nil
+}
+
+func ReadMultiPointZ(b []byte) ([]byte, MultiPointZ, error) {
+	return newDecoder(b).multiPointZ(b, nil)
+}
+
+func (d *decoder) multiPointZ(b []byte, dst MultiPointZ) ([]byte, MultiPointZ, error) {
+	b, dec, err := d.header(b, GeomMultiPointZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+PointZSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mp := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, mp[i], err = d.pointZ(b)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, mp, nil
+}
+
+func (mp MultiPointZ) IsEmpty() bool {
+	for _, p := range mp {
+		if !p.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mp MultiPointZ) Kind() Kind {
+	return GeomMultiPointZ
+}
+
+func (mp MultiPointZ) Dimension() int {
+	return 0
+}
+
+func (mp MultiPointZ) Envelope() Envelope {
+	return envelope(mp)
+}
+
+func (mp MultiPointZ) NumPoints() int {
+	return numPoints(mp)
+}
+
+func (mp MultiPointZ) Equal(other Geometry) bool {
+	o, ok := other.(MultiPointZ)
+	return ok && equalPoints(mp, o)
+}
+
+// Centroid returns center of mass of points, empty point when it is empty.
+func (mp MultiPointZ) Centroid() Point {
+	c := centroid{}
+	mp.addCentroid(&c)
+	return c.result()
+}
+
+func (mp MultiPointZ) addCentroid(c *centroid) {
+	for _, p := range mp {
+		c.point(p.X, p.Y)
+	}
+}
+
+func (mp MultiPointZ) addShape(s *shape) {
+	for _, p := range mp {
+		s.point(Point{p.X, p.Y})
+	}
+}
+
+func (mp MultiPointZ) ByteSize() int {
+	return HeaderSize + CountSize + len(mp)*(HeaderSize+PointZSize)
+}
+
+func (mp MultiPointZ) Write(buf *bytes.Buffer) {
+	buf.Write(mp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mp MultiPointZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mp.encode(e)
+	return e.release()
+}
+
+func (mp MultiPointZ) encode(e *encoder) {
+	e.header(GeomMultiPointZ)
+	e.count(len(mp))
+	for _, p := range mp {
+		p.encode(e)
+	}
+}
+
+func readPointZ(b []byte, dec binary.ByteOrder) ([]byte, PointZ) {
+	p := PointZ{}
+	b, p.X = readFloat64(b, dec)
+	b, p.Y = readFloat64(b, dec)
+	b, p.Z = readFloat64(b, dec)
+	return b, p
+}
+
+func (d *decoder) pointsZ(b []byte, dec binary.ByteOrder, dst PointsZ) ([]byte, PointsZ, error) {
+	b, n, err := d.count(b, dec, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if m := len(b) / PointZSize; n > m {
+		d.push("point")
+		d.at(m)
+		return nil, nil, d.fail(b[m*PointZSize:], ErrInvalidStorage)
+	}
+
+	p := grow(dst, n)
+	b = readCoords(b, dec, p)
+
+	return b, p, nil
+}
+
+func (pts PointsZ) byteSize() int {
+	return CountSize + len(pts)*PointZSize
+}
+
+func (pts PointsZ) encode(e *encoder) {
+	e.count(len(pts))
+	for _, p := range pts {
+		e.float64(p.X)
+		e.float64(p.Y)
+		e.float64(p.Z)
+	}
+}
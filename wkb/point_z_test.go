@@ -0,0 +1,116 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawPointZ = []byte{
+		0x01, 0xe9, 0x03, 0x00, 0x00, // header
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawMultiPointZ = []byte{
+		0x01, 0xec, 0x03, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x01, 0xe9, 0x03, 0x00, 0x00, // point 1
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x01, 0xe9, 0x03, 0x00, 0x00, // point 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+	}
+)
+
+func TestPointZ(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// missing coordinate
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0xe9, 0x03, 0x00, 0x00, // header
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+			},
+		},
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0x01, 0x00, 0x00, 0x00, // header - 2D point
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		p := PointZ{}
+		if err := p.Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	if err := (&PointZ{}).Scan(""); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+	}
+
+	p := PointZ{}
+	if assert.NoError(t, p.Scan(rawPointZ)) {
+		assert.Equal(t, PointZ{30, 10, 5}, p)
+	}
+
+	if raw, err := p.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawPointZ, raw)
+	}
+}
+
+func TestMultiPointZ(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// no payload
+			ErrInvalidStorage,
+			[]byte{0x01, 0xec, 0x03, 0x00, 0x00},
+		},
+		{
+			// element not a point
+			ErrUnsupportedValue, []byte{
+				0x01, 0xec, 0x03, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numpoints - 1
+				0x01, 0x01, 0x00, 0x00, 0x00, // 2D point
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		mp := MultiPointZ{}
+		if err := mp.Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	mp := MultiPointZ{}
+	if assert.NoError(t, mp.Scan(rawMultiPointZ)) {
+		assert.Equal(t, MultiPointZ{{10, 40, 1}, {40, 30, 2}}, mp)
+	}
+
+	if raw, err := mp.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiPointZ, raw)
+		assert.Len(t, raw, mp.ByteSize())
+	}
+}
@@ -0,0 +1,311 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"math"
+)
+
+type PointZM struct {
+	X, Y, Z, M float64
+}
+
+// EmptyPointZM returns empty point, which has NaN coordinates.
+func EmptyPointZM() PointZM {
+	nan := math.NaN()
+	return PointZM{nan, nan, nan, nan}
+}
+
+func (p PointZM) Value() (driver.Value, error) {
+	return p.MarshalBinary()
+}
+
+func (p PointZM) MarshalBinary() ([]byte, error) {
+	return p.AppendWKB(make([]byte, 0, p.ByteSize())), nil
+}
+
+func (p *PointZM) UnmarshalBinary(b []byte) error {
+	return p.unmarshal(newDecoder(b), b)
+}
+
+func (p *PointZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.pointZM(b)
+	if err != nil {
+		return err
+	}
+
+	*p = tmp
+	return nil
+}
+
+func (p *PointZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return p.UnmarshalBinary(b)
+}
+
+// IsEmpty reports whether point is empty, which is encoded with NaN coordinates.
+func (p PointZM) IsEmpty() bool {
+	return math.IsNaN(p.X) && math.IsNaN(p.Y)
+}
+
+func (p PointZM) Kind() Kind {
+	return GeomPointZM
+}
+
+func (p PointZM) Dimension() int {
+	return 0
+}
+
+func (p PointZM) Envelope() Envelope {
+	return EmptyEnvelope().add(p.X, p.Y)
+}
+
+func (p PointZM) NumPoints() int {
+	if p.IsEmpty() {
+		return 0
+	}
+	return 1
+}
+
+// Equal reports whether other is PointZM with equal coordinates, empty points are equal.
+func (p PointZM) Equal(other Geometry) bool {
+	o, ok := other.(PointZM)
+	return ok && equalPoint(p, o)
+}
+
+// Centroid returns center of mass of point, empty point when it is empty.
+func (p PointZM) Centroid() Point {
+	c := centroid{}
+	p.addCentroid(&c)
+	return c.result()
+}
+
+func (p PointZM) addCentroid(c *centroid) {
+	c.point(p.X, p.Y)
+}
+
+func (p PointZM) addShape(s *shape) {
+	s.point(Point{p.X, p.Y})
+}
+
+func (p PointZM) xy() (x, y float64) {
+	return p.X, p.Y
+}
+
+func (p PointZM) ByteSize() int {
+	return HeaderSize + PointZMSize
+}
+
+func (p PointZM) Write(buf *bytes.Buffer) {
+	buf.Write(p.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (p PointZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	p.encode(e)
+	return e.release()
+}
+
+func (p PointZM) encode(e *encoder) {
+	e.header(GeomPointZM)
+	e.float64(p.X)
+	e.float64(p.Y)
+	e.float64(p.Z)
+	e.float64(p.M)
+}
+
+func ReadPointZM(b []byte) ([]byte, PointZM, error) {
+	return newDecoder(b).pointZM(b)
+}
+
+func (d *decoder) pointZM(b []byte) ([]byte, PointZM, error) {
+	p := PointZM{}
+	b, dec, err := d.header(b, GeomPointZM)
+	if err != nil {
+		return nil, p, err
+	}
+
+	if len(b) < PointZMSize {
+		return nil, p, d.fail(b, ErrInvalidStorage)
+	}
+
+	b, p = readPointZM(b, dec)
+	return b, p, nil
+}
+
+// Scan decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPointZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mp.UnmarshalBinary(b)
+}
+
+func (mp MultiPointZM) Value() (driver.Value, error) {
+	return mp.MarshalBinary()
+}
+
+func (mp MultiPointZM) MarshalBinary() ([]byte, error) {
+	return mp.AppendWKB(make([]byte, 0, mp.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPointZM) UnmarshalBinary(b []byte) error {
+	return mp.unmarshal(newDecoder(b), b)
+}
+
+func (mp *MultiPointZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiPointZM(b, reuse(d, b, *mp))
+	if err != nil {
+		return err
+	}
+
+	*mp = tmp
+	return nil
+}
+
+func ReadMultiPointZM(b []byte) ([]byte, MultiPointZM, error) {
+	return newDecoder(b).multiPointZM(b, nil)
+}
+
+func (d *decoder) multiPointZM(b []byte, dst MultiPointZM) ([]byte, MultiPointZM, error) {
+	b, dec, err := d.header(b, GeomMultiPointZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+PointZMSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mp := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, mp[i], err = d.pointZM(b)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, mp, nil
+}
+
+func (mp MultiPointZM) IsEmpty() bool {
+	for _, p := range mp {
+		if !p.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mp MultiPointZM) Kind() Kind {
+	return GeomMultiPointZM
+}
+
+func (mp MultiPointZM) Dimension() int {
+	return 0
+}
+
+func (mp MultiPointZM) Envelope() Envelope {
+	return envelope(mp)
+}
+
+func (mp MultiPointZM) NumPoints() int {
+	return numPoints(mp)
+}
+
+func (mp MultiPointZM) Equal(other Geometry) bool {
+	o, ok := other.(MultiPointZM)
+	return ok && equalPoints(mp, o)
+}
+
+// Centroid returns center of mass of points, empty point when it is empty.
+func (mp MultiPointZM) Centroid() Point {
+	c := centroid{}
+	mp.addCentroid(&c)
+	return c.result()
+}
+
+func (mp MultiPointZM) addCentroid(c *centroid) {
+	for _, p := range mp {
+		c.point(p.X, p.Y)
+	}
+}
+
+func (mp MultiPointZM) addShape(s *shape) {
+	for _, p := range mp {
+		s.point(Point{p.X, p.Y})
+	}
+}
+
+func (mp MultiPointZM) ByteSize() int {
+	return HeaderSize + CountSize + len(mp)*(HeaderSize+PointZMSize)
+}
+
+func (mp MultiPointZM) Write(buf *bytes.Buffer) {
+	buf.Write(mp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mp MultiPointZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mp.encode(e)
+	return e.release()
+}
+
+func (mp MultiPointZM) encode(e *encoder) {
+	e.header(GeomMultiPointZM)
+	e.count(len(mp))
+	for _, p := range mp {
+		p.encode(e)
+	}
+}
+
+func readPointZM(b []byte, dec binary.ByteOrder) ([]byte, PointZM) {
+	p := PointZM{}
+	b, p.X = readFloat64(b, dec)
+	b, p.Y = readFloat64(b, dec)
+	b, p.Z = readFloat64(b, dec)
+	b, p.M = readFloat64(b, dec)
+	return b, p
+}
+
+func (d *decoder) pointsZM(b []byte, dec binary.ByteOrder, dst PointsZM) ([]byte, PointsZM, error) {
+	b, n, err := d.count(b, dec, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if m := len(b) / PointZMSize; n > m {
+		d.push("point")
+		d.at(m)
+		return nil, nil, d.fail(b[m*PointZMSize:], ErrInvalidStorage)
+	}
+
+	p := grow(dst, n)
+	b = readCoords(b, dec, p)
+
+	return b, p, nil
+}
+
+func (pts PointsZM) byteSize() int {
+	return CountSize + len(pts)*PointZMSize
+}
+
+func (pts PointsZM) encode(e *encoder) {
+	e.count(len(pts))
+	for _, p := range pts {
+		e.float64(p.X)
+		e.float64(p.Y)
+		e.float64(p.Z)
+		e.float64(p.M)
+	}
+}
@@ -0,0 +1,122 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawPointZM = []byte{
+		0x01, 0xb9, 0x0b, 0x00, 0x00, // header
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+	}
+	rawMultiPointZM = []byte{
+		0x01, 0xbc, 0x0b, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x01, 0xb9, 0x0b, 0x00, 0x00, // point 1
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x01, 0xb9, 0x0b, 0x00, 0x00, // point 2
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x40,
+	}
+)
+
+func TestPointZM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// missing coordinate
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0xb9, 0x0b, 0x00, 0x00, // header
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+			},
+		},
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0x01, 0x00, 0x00, 0x00, // header - 2D point
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		p := PointZM{}
+		if err := p.Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	if err := (&PointZM{}).Scan(""); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+	}
+
+	p := PointZM{}
+	if assert.NoError(t, p.Scan(rawPointZM)) {
+		assert.Equal(t, PointZM{30, 10, 5, 2}, p)
+	}
+
+	if raw, err := p.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawPointZM, raw)
+	}
+}
+
+func TestMultiPointZM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// no payload
+			ErrInvalidStorage,
+			[]byte{0x01, 0xbc, 0x0b, 0x00, 0x00},
+		},
+		{
+			// element not a point
+			ErrUnsupportedValue, []byte{
+				0x01, 0xbc, 0x0b, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numpoints - 1
+				0x01, 0x01, 0x00, 0x00, 0x00, // 2D point
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		mp := MultiPointZM{}
+		if err := mp.Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	mp := MultiPointZM{}
+	if assert.NoError(t, mp.Scan(rawMultiPointZM)) {
+		assert.Equal(t, MultiPointZM{{10, 40, 1, 7}, {40, 30, 2, 8}}, mp)
+	}
+
+	if raw, err := mp.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiPointZM, raw)
+		assert.Len(t, raw, mp.ByteSize())
+	}
+}
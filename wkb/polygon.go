@@ -6,54 +6,152 @@ import (
 	"encoding/binary"
 )
 
+// Scan decodes geometry into p, reusing its backing arrays as UnmarshalTo does.
 func (p *Polygon) Scan(src interface{}) error {
-	b, ok := src.([]byte)
-	if !ok {
-		return ErrInvalidStorage
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
 	}
+	return p.UnmarshalBinary(b)
+}
+
+func (p Polygon) Value() (driver.Value, error) {
+	return p.MarshalBinary()
+}
+
+func (p Polygon) MarshalBinary() ([]byte, error) {
+	return p.AppendWKB(make([]byte, 0, p.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into p, reusing its backing arrays as UnmarshalTo does.
+func (p *Polygon) UnmarshalBinary(b []byte) error {
+	return p.unmarshal(newDecoder(b), b)
+}
 
-	_, tmp, err := ReadPolygon(b)
+func (p *Polygon) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.polygon(b, reuse(d, b, *p))
 	if err != nil {
 		return err
 	}
 
 	*p = tmp
-	return err
+	return nil
 }
 
-func (p Polygon) Value() (driver.Value, error) {
-	buf := bytes.NewBuffer(make([]byte, 0, p.ByteSize()))
-	p.Write(buf)
-	return buf.Bytes(), nil
+func ReadPolygon(b []byte) ([]byte, Polygon, error) {
+	return newDecoder(b).polygon(b, nil)
 }
 
-func ReadPolygon(b []byte) ([]byte, Polygon, error) {
-	if len(b) < HeaderSize+CountSize {
-		return nil, nil, ErrInvalidStorage
+func (d *decoder) polygon(b []byte, dst Polygon) ([]byte, Polygon, error) {
+	b, dec, err := d.header(b, GeomPolygon)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	b, dec, err := header(b, GeomPolygon)
+	b, n, err := d.count(b, dec, CountSize)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	b, n := readCount(b, dec)
-
-	p := make([]LinearRing, n)
+	p := grow(dst, n)
+	d.push("ring")
 	for i := 0; i < n; i++ {
-		if len(b) < CountSize {
-			return nil, nil, ErrInvalidStorage
-		}
-
-		b, p[i], err = readLinearRing(b, dec)
+		d.at(i)
+		b, p[i], err = d.linearRing(b, dec, p[i])
 		if err != nil {
 			return nil, nil, err
 		}
 	}
+	d.pop()
 
 	return b, p, nil
 }
 
+func (p Polygon) IsEmpty() bool {
+	for _, lr := range p {
+		if len(lr) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p Polygon) Kind() Kind {
+	return GeomPolygon
+}
+
+func (p Polygon) Dimension() int {
+	return 2
+}
+
+func (p Polygon) Envelope() Envelope {
+	e := EmptyEnvelope()
+	for _, r := range p {
+		e = e.Union(envelope(r))
+	}
+	return e
+}
+
+func (p Polygon) NumPoints() int {
+	n := 0
+	for _, r := range p {
+		n += len(r)
+	}
+	return n
+}
+
+func (p Polygon) Equal(other Geometry) bool {
+	o, ok := other.(Polygon)
+	if !ok || len(p) != len(o) {
+		return false
+	}
+	for i := range p {
+		if !equalPoints(p[i], o[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Area returns area of exterior ring less areas of interior rings.
+func (p Polygon) Area() float64 {
+	area := 0.0
+	for i, r := range p {
+		if i == 0 {
+			area += ringArea(r)
+		} else {
+			area -= ringArea(r)
+		}
+	}
+	return area
+}
+
+// Perimeter returns total length of rings.
+func (p Polygon) Perimeter() float64 {
+	length := 0.0
+	for _, r := range p {
+		length += lineLength(r)
+	}
+	return length
+}
+
+// Centroid returns center of mass of polygon, empty point when it is empty.
+func (p Polygon) Centroid() Point {
+	c := centroid{}
+	p.addCentroid(&c)
+	return c.result()
+}
+
+func (p Polygon) addCentroid(c *centroid) {
+	for i, r := range p {
+		addRing(c, r, i > 0)
+	}
+}
+
+func (p Polygon) addShape(s *shape) {
+	s.polygon(p)
+}
+
 func (p Polygon) ByteSize() int {
 	size := HeaderSize + CountSize
 	for _, lr := range p {
@@ -63,20 +161,47 @@ func (p Polygon) ByteSize() int {
 }
 
 func (p Polygon) Write(buf *bytes.Buffer) {
-	writeHeader(buf, GeomPolygon)
-	writeCount(buf, len(p))
+	buf.Write(p.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (p Polygon) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	p.encode(e)
+	return e.release()
+}
+
+func (p Polygon) encode(e *encoder) {
+	e.header(GeomPolygon)
+	e.count(len(p))
 	for _, lr := range p {
-		lr.write(buf)
+		lr.encode(e)
 	}
 }
 
+// Scan decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
 func (mp *MultiPolygon) Scan(src interface{}) error {
-	b, ok := src.([]byte)
-	if !ok {
-		return ErrInvalidStorage
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
 	}
+	return mp.UnmarshalBinary(b)
+}
+
+func (mp MultiPolygon) Value() (driver.Value, error) {
+	return mp.MarshalBinary()
+}
+
+func (mp MultiPolygon) MarshalBinary() ([]byte, error) {
+	return mp.AppendWKB(make([]byte, 0, mp.ByteSize())), nil
+}
 
-	_, tmp, err := ReadMultiPolygon(b)
+// UnmarshalBinary decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPolygon) UnmarshalBinary(b []byte) error {
+	return mp.unmarshal(newDecoder(b), b)
+}
+
+func (mp *MultiPolygon) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiPolygon(b, reuse(d, b, *mp))
 	if err != nil {
 		return err
 	}
@@ -85,35 +210,100 @@ func (mp *MultiPolygon) Scan(src interface{}) error {
 	return nil
 }
 
-func (mp MultiPolygon) Value() (driver.Value, error) {
-	buf := bytes.NewBuffer(make([]byte, 0, mp.ByteSize()))
-	mp.Write(buf)
-	return buf.Bytes(), nil
+func ReadMultiPolygon(b []byte) ([]byte, MultiPolygon, error) {
+	return newDecoder(b).multiPolygon(b, nil)
 }
 
-func ReadMultiPolygon(b []byte) ([]byte, MultiPolygon, error) {
-	if len(b) < HeaderSize+CountSize {
-		return nil, nil, ErrInvalidStorage
+func (d *decoder) multiPolygon(b []byte, dst MultiPolygon) ([]byte, MultiPolygon, error) {
+	b, dec, err := d.header(b, GeomMultiPolygon)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	b, dec, err := header(b, GeomMultiPolygon)
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	b, n := readCount(b, dec)
-
-	mp := make([]Polygon, n)
+	mp := grow(dst, n)
+	d.push("")
 	for i := 0; i < n; i++ {
-		b, mp[i], err = ReadPolygon(b)
+		d.at(i)
+		b, mp[i], err = d.polygon(b, mp[i])
 		if err != nil {
 			return nil, nil, err
 		}
 	}
+	d.pop()
 
 	return b, mp, nil
 }
 
+func (mp MultiPolygon) IsEmpty() bool {
+	for _, p := range mp {
+		if !p.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mp MultiPolygon) Kind() Kind {
+	return GeomMultiPolygon
+}
+
+func (mp MultiPolygon) Dimension() int {
+	return 2
+}
+
+func (mp MultiPolygon) Envelope() Envelope {
+	return envelopeAll(mp)
+}
+
+func (mp MultiPolygon) NumPoints() int {
+	return numPointsAll(mp)
+}
+
+func (mp MultiPolygon) Equal(other Geometry) bool {
+	o, ok := other.(MultiPolygon)
+	return ok && equalAll(mp, o)
+}
+
+func (mp MultiPolygon) Area() float64 {
+	area := 0.0
+	for _, p := range mp {
+		area += p.Area()
+	}
+	return area
+}
+
+func (mp MultiPolygon) Perimeter() float64 {
+	length := 0.0
+	for _, p := range mp {
+		length += p.Perimeter()
+	}
+	return length
+}
+
+// Centroid returns center of mass of polygons, empty point when it is empty.
+func (mp MultiPolygon) Centroid() Point {
+	c := centroid{}
+	mp.addCentroid(&c)
+	return c.result()
+}
+
+func (mp MultiPolygon) addCentroid(c *centroid) {
+	for _, p := range mp {
+		p.addCentroid(c)
+	}
+}
+
+func (mp MultiPolygon) addShape(s *shape) {
+	for _, p := range mp {
+		s.polygon(p)
+	}
+}
+
 func (mp MultiPolygon) ByteSize() int {
 	size := HeaderSize + CountSize
 	for _, p := range mp {
@@ -123,15 +313,25 @@ func (mp MultiPolygon) ByteSize() int {
 }
 
 func (mp MultiPolygon) Write(buf *bytes.Buffer) {
-	writeHeader(buf, GeomMultiPolygon)
-	writeCount(buf, len(mp))
+	buf.Write(mp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mp MultiPolygon) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mp.encode(e)
+	return e.release()
+}
+
+func (mp MultiPolygon) encode(e *encoder) {
+	e.header(GeomMultiPolygon)
+	e.count(len(mp))
 	for _, p := range mp {
-		p.Write(buf)
+		p.encode(e)
 	}
 }
 
-func readLinearRing(b []byte, dec binary.ByteOrder) ([]byte, LinearRing, error) {
-	b, pts, err := readPoints(b, dec)
+func (d *decoder) linearRing(b []byte, dec binary.ByteOrder, dst LinearRing) ([]byte, LinearRing, error) {
+	b, pts, err := d.points(b, dec, Points(dst))
 	return b, LinearRing(pts), err
 }
 
@@ -139,6 +339,6 @@ func (lr LinearRing) byteSize() int {
 	return Points(lr).byteSize()
 }
 
-func (lr LinearRing) write(buf *bytes.Buffer) {
-	Points(lr).write(buf)
+func (lr LinearRing) encode(e *encoder) {
+	Points(lr).encode(e)
 }
@@ -0,0 +1,344 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+)
+
+// Scan decodes geometry into p, reusing its backing arrays as UnmarshalTo does.
+func (p *PolygonM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return p.UnmarshalBinary(b)
+}
+
+func (p PolygonM) Value() (driver.Value, error) {
+	return p.MarshalBinary()
+}
+
+func (p PolygonM) MarshalBinary() ([]byte, error) {
+	return p.AppendWKB(make([]byte, 0, p.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into p, reusing its backing arrays as UnmarshalTo does.
+func (p *PolygonM) UnmarshalBinary(b []byte) error {
+	return p.unmarshal(newDecoder(b), b)
+}
+
+func (p *PolygonM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.polygonM(b, reuse(d, b, *p))
+	if err != nil {
+		return err
+	}
+
+	*p = tmp
+	return nil
+}
+
+func ReadPolygonM(b []byte) ([]byte, PolygonM, error) {
+	return newDecoder(b).polygonM(b, nil)
+}
+
+func (d *decoder) polygonM(b []byte, dst PolygonM) ([]byte, PolygonM, error) {
+	b, dec, err := d.header(b, GeomPolygonM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := grow(dst, n)
+	d.push("ring")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, p[i], err = d.linearRingM(b, dec, p[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, p, nil
+}
+
+func (p PolygonM) IsEmpty() bool {
+	for _, lr := range p {
+		if len(lr) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p PolygonM) Kind() Kind {
+	return GeomPolygonM
+}
+
+func (p PolygonM) Dimension() int {
+	return 2
+}
+
+func (p PolygonM) Envelope() Envelope {
+	e := EmptyEnvelope()
+	for _, r := range p {
+		e = e.Union(envelope(r))
+	}
+	return e
+}
+
+func (p PolygonM) NumPoints() int {
+	n := 0
+	for _, r := range p {
+		n += len(r)
+	}
+	return n
+}
+
+func (p PolygonM) Equal(other Geometry) bool {
+	o, ok := other.(PolygonM)
+	if !ok || len(p) != len(o) {
+		return false
+	}
+	for i := range p {
+		if !equalPoints(p[i], o[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Area returns area of exterior ring less areas of interior rings.
+func (p PolygonM) Area() float64 {
+	area := 0.0
+	for i, r := range p {
+		if i == 0 {
+			area += ringArea(r)
+		} else {
+			area -= ringArea(r)
+		}
+	}
+	return area
+}
+
+// Perimeter returns total length of rings.
+func (p PolygonM) Perimeter() float64 {
+	length := 0.0
+	for _, r := range p {
+		length += lineLength(r)
+	}
+	return length
+}
+
+// Centroid returns center of mass of polygon, empty point when it is empty.
+func (p PolygonM) Centroid() Point {
+	c := centroid{}
+	p.addCentroid(&c)
+	return c.result()
+}
+
+func (p PolygonM) addCentroid(c *centroid) {
+	for i, r := range p {
+		addRing(c, r, i > 0)
+	}
+}
+
+func (p PolygonM) addShape(s *shape) {
+	s.polygon(projectPolygon(p))
+}
+
+func (p PolygonM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, lr := range p {
+		size += lr.byteSize()
+	}
+	return size
+}
+
+func (p PolygonM) Write(buf *bytes.Buffer) {
+	buf.Write(p.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (p PolygonM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	p.encode(e)
+	return e.release()
+}
+
+func (p PolygonM) encode(e *encoder) {
+	e.header(GeomPolygonM)
+	e.count(len(p))
+	for _, lr := range p {
+		lr.encode(e)
+	}
+}
+
+// Scan decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPolygonM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mp.UnmarshalBinary(b)
+}
+
+func (mp MultiPolygonM) Value() (driver.Value, error) {
+	return mp.MarshalBinary()
+}
+
+func (mp MultiPolygonM) MarshalBinary() ([]byte, error) {
+	return mp.AppendWKB(make([]byte, 0, mp.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPolygonM) UnmarshalBinary(b []byte) error {
+	return mp.unmarshal(newDecoder(b), b)
+}
+
+func (mp *MultiPolygonM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiPolygonM(b, reuse(d, b, *mp))
+	if err != nil {
+		return err
+	}
+
+	*mp = tmp
+	return nil
+}
+
+func ReadMultiPolygonM(b []byte) ([]byte, MultiPolygonM, error) {
+	return newDecoder(b).multiPolygonM(b, nil)
+}
+
+func (d *decoder) multiPolygonM(b []byte, dst MultiPolygonM) ([]byte, MultiPolygonM, error) {
+	b, dec, err := d.header(b, GeomMultiPolygonM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mp := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, mp[i], err = d.polygonM(b, mp[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, mp, nil
+}
+
+func (mp MultiPolygonM) IsEmpty() bool {
+	for _, p := range mp {
+		if !p.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mp MultiPolygonM) Kind() Kind {
+	return GeomMultiPolygonM
+}
+
+func (mp MultiPolygonM) Dimension() int {
+	return 2
+}
+
+func (mp MultiPolygonM) Envelope() Envelope {
+	return envelopeAll(mp)
+}
+
+func (mp MultiPolygonM) NumPoints() int {
+	return numPointsAll(mp)
+}
+
+func (mp MultiPolygonM) Equal(other Geometry) bool {
+	o, ok := other.(MultiPolygonM)
+	return ok && equalAll(mp, o)
+}
+
+func (mp MultiPolygonM) Area() float64 {
+	area := 0.0
+	for _, p := range mp {
+		area += p.Area()
+	}
+	return area
+}
+
+func (mp MultiPolygonM) Perimeter() float64 {
+	length := 0.0
+	for _, p := range mp {
+		length += p.Perimeter()
+	}
+	return length
+}
+
+// Centroid returns center of mass of polygons, empty point when it is empty.
+func (mp MultiPolygonM) Centroid() Point {
+	c := centroid{}
+	mp.addCentroid(&c)
+	return c.result()
+}
+
+func (mp MultiPolygonM) addCentroid(c *centroid) {
+	for _, p := range mp {
+		p.addCentroid(c)
+	}
+}
+
+func (mp MultiPolygonM) addShape(s *shape) {
+	for _, p := range mp {
+		p.addShape(s)
+	}
+}
+
+func (mp MultiPolygonM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, p := range mp {
+		size += p.ByteSize()
+	}
+	return size
+}
+
+func (mp MultiPolygonM) Write(buf *bytes.Buffer) {
+	buf.Write(mp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mp MultiPolygonM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mp.encode(e)
+	return e.release()
+}
+
+func (mp MultiPolygonM) encode(e *encoder) {
+	e.header(GeomMultiPolygonM)
+	e.count(len(mp))
+	for _, p := range mp {
+		p.encode(e)
+	}
+}
+
+func (d *decoder) linearRingM(b []byte, dec binary.ByteOrder, dst LinearRingM) ([]byte, LinearRingM, error) {
+	b, pts, err := d.pointsM(b, dec, PointsM(dst))
+	return b, LinearRingM(pts), err
+}
+
+func (lr LinearRingM) byteSize() int {
+	return PointsM(lr).byteSize()
+}
+
+func (lr LinearRingM) encode(e *encoder) {
+	PointsM(lr).encode(e)
+}
@@ -0,0 +1,118 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawPolygonM = []byte{
+		0x01, 0xd3, 0x07, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numlinearring - 2
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	rawMultiPolygonM = []byte{
+		0x01, 0xd6, 0x07, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numpolygon - 1
+		0x01, 0xd3, 0x07, 0x00, 0x00, // polygon 1
+		0x01, 0x00, 0x00, 0x00, // numlinearring - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+	}
+)
+
+func TestPolygonM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// 2D polygon
+			ErrUnsupportedValue,
+			rawPolygon,
+		},
+		{
+			// no elements
+			ErrInvalidStorage,
+			[]byte{
+				0x01, 0xd3, 0x07, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numlinearring - 1
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		p := PolygonM{}
+		if err := p.Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	p := PolygonM{}
+	if assert.NoError(t, p.Scan(rawPolygonM)) {
+		assert.Equal(t, PolygonM{
+			LinearRingM{{30, 10, 1}, {40, 40, 2}, {20, 40, 3}, {30, 10, 1}},
+			LinearRingM{{30, 20, 0}, {32, 30, 0}, {28, 30, 0}, {30, 20, 0}},
+		}, p)
+	}
+
+	if raw, err := p.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawPolygonM, raw)
+	}
+}
+
+func TestMultiPolygonM(t *testing.T) {
+	if err := (&MultiPolygonM{}).Scan(rawMultiPolygon); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	mp := MultiPolygonM{}
+	if assert.NoError(t, mp.Scan(rawMultiPolygonM)) {
+		assert.Equal(t, MultiPolygonM{
+			PolygonM{
+				LinearRingM{{30, 10, 1}, {40, 40, 2}, {20, 40, 3}, {30, 10, 1}},
+			},
+		}, mp)
+	}
+
+	if raw, err := mp.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiPolygonM, raw)
+	}
+}
@@ -93,12 +93,12 @@ func TestPolygon(t *testing.T) {
 	for _, e := range invalid {
 		p := Polygon{}
 		if err := p.Scan(e.b); assert.Error(t, err) {
-			assert.Exactly(t, e.err, err)
+			assert.ErrorIs(t, err, e.err)
 		}
 	}
 
 	if err := (&Polygon{}).Scan(""); assert.Error(t, err) {
-		assert.Exactly(t, ErrInvalidStorage, err)
+		assert.ErrorIs(t, err, ErrInvalidStorage)
 	}
 
 	p := Polygon{}
@@ -163,12 +163,12 @@ func TestMultiPolygon(t *testing.T) {
 	for _, e := range invalid {
 		mp := MultiPolygon{}
 		if err := mp.Scan(e.b); assert.Error(t, err) {
-			assert.Exactly(t, e.err, err, "Expected MultiPolygon <%v> to fail", hex.EncodeToString(e.b))
+			assert.ErrorIs(t, err, e.err, "Expected MultiPolygon <%v> to fail", hex.EncodeToString(e.b))
 		}
 	}
 
 	if err := (&MultiPolygon{}).Scan(""); assert.Error(t, err) {
-		assert.Exactly(t, ErrInvalidStorage, err)
+		assert.ErrorIs(t, err, ErrInvalidStorage)
 	}
 
 	mp := MultiPolygon{}
@@ -187,3 +187,31 @@ func TestMultiPolygon(t *testing.T) {
 		assert.Equal(t, rawMultiPolygon, raw)
 	}
 }
+
+func BenchmarkReadPolygon(b *testing.B) {
+	p := Polygon{LinearRing(coastline(1000000))}
+	for _, order := range []ByteOrder{LittleEndian, BigEndian} {
+		name := map[ByteOrder]string{LittleEndian: "ndr", BigEndian: "xdr"}[order]
+		raw := Marshal(p, WithByteOrder(order))
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(raw)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, _, err := ReadPolygon(raw); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(name+"/reuse", func(b *testing.B) {
+			dst := Polygon{}
+			b.SetBytes(int64(len(raw)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := dst.UnmarshalBinary(raw); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
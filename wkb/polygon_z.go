@@ -0,0 +1,344 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+)
+
+// Scan decodes geometry into p, reusing its backing arrays as UnmarshalTo does.
+func (p *PolygonZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return p.UnmarshalBinary(b)
+}
+
+func (p PolygonZ) Value() (driver.Value, error) {
+	return p.MarshalBinary()
+}
+
+func (p PolygonZ) MarshalBinary() ([]byte, error) {
+	return p.AppendWKB(make([]byte, 0, p.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into p, reusing its backing arrays as UnmarshalTo does.
+func (p *PolygonZ) UnmarshalBinary(b []byte) error {
+	return p.unmarshal(newDecoder(b), b)
+}
+
+func (p *PolygonZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.polygonZ(b, reuse(d, b, *p))
+	if err != nil {
+		return err
+	}
+
+	*p = tmp
+	return nil
+}
+
+func ReadPolygonZ(b []byte) ([]byte, PolygonZ, error) {
+	return newDecoder(b).polygonZ(b, nil)
+}
+
+func (d *decoder) polygonZ(b []byte, dst PolygonZ) ([]byte, PolygonZ, error) {
+	b, dec, err := d.header(b, GeomPolygonZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := grow(dst, n)
+	d.push("ring")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, p[i], err = d.linearRingZ(b, dec, p[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, p, nil
+}
+
+func (p PolygonZ) IsEmpty() bool {
+	for _, lr := range p {
+		if len(lr) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p PolygonZ) Kind() Kind {
+	return GeomPolygonZ
+}
+
+func (p PolygonZ) Dimension() int {
+	return 2
+}
+
+func (p PolygonZ) Envelope() Envelope {
+	e := EmptyEnvelope()
+	for _, r := range p {
+		e = e.Union(envelope(r))
+	}
+	return e
+}
+
+func (p PolygonZ) NumPoints() int {
+	n := 0
+	for _, r := range p {
+		n += len(r)
+	}
+	return n
+}
+
+func (p PolygonZ) Equal(other Geometry) bool {
+	o, ok := other.(PolygonZ)
+	if !ok || len(p) != len(o) {
+		return false
+	}
+	for i := range p {
+		if !equalPoints(p[i], o[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Area returns area of exterior ring less areas of interior rings.
+func (p PolygonZ) Area() float64 {
+	area := 0.0
+	for i, r := range p {
+		if i == 0 {
+			area += ringArea(r)
+		} else {
+			area -= ringArea(r)
+		}
+	}
+	return area
+}
+
+// Perimeter returns total length of rings.
+func (p PolygonZ) Perimeter() float64 {
+	length := 0.0
+	for _, r := range p {
+		length += lineLength(r)
+	}
+	return length
+}
+
+// Centroid returns center of mass of polygon, empty point when it is empty.
+func (p PolygonZ) Centroid() Point {
+	c := centroid{}
+	p.addCentroid(&c)
+	return c.result()
+}
+
+func (p PolygonZ) addCentroid(c *centroid) {
+	for i, r := range p {
+		addRing(c, r, i > 0)
+	}
+}
+
+func (p PolygonZ) addShape(s *shape) {
+	s.polygon(projectPolygon(p))
+}
+
+func (p PolygonZ) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, lr := range p {
+		size += lr.byteSize()
+	}
+	return size
+}
+
+func (p PolygonZ) Write(buf *bytes.Buffer) {
+	buf.Write(p.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (p PolygonZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	p.encode(e)
+	return e.release()
+}
+
+func (p PolygonZ) encode(e *encoder) {
+	e.header(GeomPolygonZ)
+	e.count(len(p))
+	for _, lr := range p {
+		lr.encode(e)
+	}
+}
+
+// Scan decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPolygonZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mp.UnmarshalBinary(b)
+}
+
+func (mp MultiPolygonZ) Value() (driver.Value, error) {
+	return mp.MarshalBinary()
+}
+
+func (mp MultiPolygonZ) MarshalBinary() ([]byte, error) {
+	return mp.AppendWKB(make([]byte, 0, mp.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPolygonZ) UnmarshalBinary(b []byte) error {
+	return mp.unmarshal(newDecoder(b), b)
+}
+
+func (mp *MultiPolygonZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiPolygonZ(b, reuse(d, b, *mp))
+	if err != nil {
+		return err
+	}
+
+	*mp = tmp
+	return nil
+}
+
+func ReadMultiPolygonZ(b []byte) ([]byte, MultiPolygonZ, error) {
+	return newDecoder(b).multiPolygonZ(b, nil)
+}
+
+func (d *decoder) multiPolygonZ(b []byte, dst MultiPolygonZ) ([]byte, MultiPolygonZ, error) {
+	b, dec, err := d.header(b, GeomMultiPolygonZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mp := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, mp[i], err = d.polygonZ(b, mp[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, mp, nil
+}
+
+func (mp MultiPolygonZ) IsEmpty() bool {
+	for _, p := range mp {
+		if !p.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mp MultiPolygonZ) Kind() Kind {
+	return GeomMultiPolygonZ
+}
+
+func (mp MultiPolygonZ) Dimension() int {
+	return 2
+}
+
+func (mp MultiPolygonZ) Envelope() Envelope {
+	return envelopeAll(mp)
+}
+
+func (mp MultiPolygonZ) NumPoints() int {
+	return numPointsAll(mp)
+}
+
+func (mp MultiPolygonZ) Equal(other Geometry) bool {
+	o, ok := other.(MultiPolygonZ)
+	return ok && equalAll(mp, o)
+}
+
+func (mp MultiPolygonZ) Area() float64 {
+	area := 0.0
+	for _, p := range mp {
+		area += p.Area()
+	}
+	return area
+}
+
+func (mp MultiPolygonZ) Perimeter() float64 {
+	length := 0.0
+	for _, p := range mp {
+		length += p.Perimeter()
+	}
+	return length
+}
+
+// Centroid returns center of mass of polygons, empty point when it is empty.
+func (mp MultiPolygonZ) Centroid() Point {
+	c := centroid{}
+	mp.addCentroid(&c)
+	return c.result()
+}
+
+func (mp MultiPolygonZ) addCentroid(c *centroid) {
+	for _, p := range mp {
+		p.addCentroid(c)
+	}
+}
+
+func (mp MultiPolygonZ) addShape(s *shape) {
+	for _, p := range mp {
+		p.addShape(s)
+	}
+}
+
+func (mp MultiPolygonZ) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, p := range mp {
+		size += p.ByteSize()
+	}
+	return size
+}
+
+func (mp MultiPolygonZ) Write(buf *bytes.Buffer) {
+	buf.Write(mp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mp MultiPolygonZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mp.encode(e)
+	return e.release()
+}
+
+func (mp MultiPolygonZ) encode(e *encoder) {
+	e.header(GeomMultiPolygonZ)
+	e.count(len(mp))
+	for _, p := range mp {
+		p.encode(e)
+	}
+}
+
+func (d *decoder) linearRingZ(b []byte, dec binary.ByteOrder, dst LinearRingZ) ([]byte, LinearRingZ, error) {
+	b, pts, err := d.pointsZ(b, dec, PointsZ(dst))
+	return b, LinearRingZ(pts), err
+}
+
+func (lr LinearRingZ) byteSize() int {
+	return PointsZ(lr).byteSize()
+}
+
+func (lr LinearRingZ) encode(e *encoder) {
+	PointsZ(lr).encode(e)
+}
@@ -0,0 +1,344 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+)
+
+// Scan decodes geometry into p, reusing its backing arrays as UnmarshalTo does.
+func (p *PolygonZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return p.UnmarshalBinary(b)
+}
+
+func (p PolygonZM) Value() (driver.Value, error) {
+	return p.MarshalBinary()
+}
+
+func (p PolygonZM) MarshalBinary() ([]byte, error) {
+	return p.AppendWKB(make([]byte, 0, p.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into p, reusing its backing arrays as UnmarshalTo does.
+func (p *PolygonZM) UnmarshalBinary(b []byte) error {
+	return p.unmarshal(newDecoder(b), b)
+}
+
+func (p *PolygonZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.polygonZM(b, reuse(d, b, *p))
+	if err != nil {
+		return err
+	}
+
+	*p = tmp
+	return nil
+}
+
+func ReadPolygonZM(b []byte) ([]byte, PolygonZM, error) {
+	return newDecoder(b).polygonZM(b, nil)
+}
+
+func (d *decoder) polygonZM(b []byte, dst PolygonZM) ([]byte, PolygonZM, error) {
+	b, dec, err := d.header(b, GeomPolygonZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := grow(dst, n)
+	d.push("ring")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, p[i], err = d.linearRingZM(b, dec, p[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, p, nil
+}
+
+func (p PolygonZM) IsEmpty() bool {
+	for _, lr := range p {
+		if len(lr) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (p PolygonZM) Kind() Kind {
+	return GeomPolygonZM
+}
+
+func (p PolygonZM) Dimension() int {
+	return 2
+}
+
+func (p PolygonZM) Envelope() Envelope {
+	e := EmptyEnvelope()
+	for _, r := range p {
+		e = e.Union(envelope(r))
+	}
+	return e
+}
+
+func (p PolygonZM) NumPoints() int {
+	n := 0
+	for _, r := range p {
+		n += len(r)
+	}
+	return n
+}
+
+func (p PolygonZM) Equal(other Geometry) bool {
+	o, ok := other.(PolygonZM)
+	if !ok || len(p) != len(o) {
+		return false
+	}
+	for i := range p {
+		if !equalPoints(p[i], o[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Area returns area of exterior ring less areas of interior rings.
+func (p PolygonZM) Area() float64 {
+	area := 0.0
+	for i, r := range p {
+		if i == 0 {
+			area += ringArea(r)
+		} else {
+			area -= ringArea(r)
+		}
+	}
+	return area
+}
+
+// Perimeter returns total length of rings.
+func (p PolygonZM) Perimeter() float64 {
+	length := 0.0
+	for _, r := range p {
+		length += lineLength(r)
+	}
+	return length
+}
+
+// Centroid returns center of mass of polygon, empty point when it is empty.
+func (p PolygonZM) Centroid() Point {
+	c := centroid{}
+	p.addCentroid(&c)
+	return c.result()
+}
+
+func (p PolygonZM) addCentroid(c *centroid) {
+	for i, r := range p {
+		addRing(c, r, i > 0)
+	}
+}
+
+func (p PolygonZM) addShape(s *shape) {
+	s.polygon(projectPolygon(p))
+}
+
+func (p PolygonZM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, lr := range p {
+		size += lr.byteSize()
+	}
+	return size
+}
+
+func (p PolygonZM) Write(buf *bytes.Buffer) {
+	buf.Write(p.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (p PolygonZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	p.encode(e)
+	return e.release()
+}
+
+func (p PolygonZM) encode(e *encoder) {
+	e.header(GeomPolygonZM)
+	e.count(len(p))
+	for _, lr := range p {
+		lr.encode(e)
+	}
+}
+
+// Scan decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPolygonZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return mp.UnmarshalBinary(b)
+}
+
+func (mp MultiPolygonZM) Value() (driver.Value, error) {
+	return mp.MarshalBinary()
+}
+
+func (mp MultiPolygonZM) MarshalBinary() ([]byte, error) {
+	return mp.AppendWKB(make([]byte, 0, mp.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into mp, reusing its backing arrays as UnmarshalTo does.
+func (mp *MultiPolygonZM) UnmarshalBinary(b []byte) error {
+	return mp.unmarshal(newDecoder(b), b)
+}
+
+func (mp *MultiPolygonZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiPolygonZM(b, reuse(d, b, *mp))
+	if err != nil {
+		return err
+	}
+
+	*mp = tmp
+	return nil
+}
+
+func ReadMultiPolygonZM(b []byte) ([]byte, MultiPolygonZM, error) {
+	return newDecoder(b).multiPolygonZM(b, nil)
+}
+
+func (d *decoder) multiPolygonZM(b []byte, dst MultiPolygonZM) ([]byte, MultiPolygonZM, error) {
+	b, dec, err := d.header(b, GeomMultiPolygonZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mp := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, mp[i], err = d.polygonZM(b, mp[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, mp, nil
+}
+
+func (mp MultiPolygonZM) IsEmpty() bool {
+	for _, p := range mp {
+		if !p.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (mp MultiPolygonZM) Kind() Kind {
+	return GeomMultiPolygonZM
+}
+
+func (mp MultiPolygonZM) Dimension() int {
+	return 2
+}
+
+func (mp MultiPolygonZM) Envelope() Envelope {
+	return envelopeAll(mp)
+}
+
+func (mp MultiPolygonZM) NumPoints() int {
+	return numPointsAll(mp)
+}
+
+func (mp MultiPolygonZM) Equal(other Geometry) bool {
+	o, ok := other.(MultiPolygonZM)
+	return ok && equalAll(mp, o)
+}
+
+func (mp MultiPolygonZM) Area() float64 {
+	area := 0.0
+	for _, p := range mp {
+		area += p.Area()
+	}
+	return area
+}
+
+func (mp MultiPolygonZM) Perimeter() float64 {
+	length := 0.0
+	for _, p := range mp {
+		length += p.Perimeter()
+	}
+	return length
+}
+
+// Centroid returns center of mass of polygons, empty point when it is empty.
+func (mp MultiPolygonZM) Centroid() Point {
+	c := centroid{}
+	mp.addCentroid(&c)
+	return c.result()
+}
+
+func (mp MultiPolygonZM) addCentroid(c *centroid) {
+	for _, p := range mp {
+		p.addCentroid(c)
+	}
+}
+
+func (mp MultiPolygonZM) addShape(s *shape) {
+	for _, p := range mp {
+		p.addShape(s)
+	}
+}
+
+func (mp MultiPolygonZM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, p := range mp {
+		size += p.ByteSize()
+	}
+	return size
+}
+
+func (mp MultiPolygonZM) Write(buf *bytes.Buffer) {
+	buf.Write(mp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (mp MultiPolygonZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	mp.encode(e)
+	return e.release()
+}
+
+func (mp MultiPolygonZM) encode(e *encoder) {
+	e.header(GeomMultiPolygonZM)
+	e.count(len(mp))
+	for _, p := range mp {
+		p.encode(e)
+	}
+}
+
+func (d *decoder) linearRingZM(b []byte, dec binary.ByteOrder, dst LinearRingZM) ([]byte, LinearRingZM, error) {
+	b, pts, err := d.pointsZM(b, dec, PointsZM(dst))
+	return b, LinearRingZM(pts), err
+}
+
+func (lr LinearRingZM) byteSize() int {
+	return PointsZM(lr).byteSize()
+}
+
+func (lr LinearRingZM) encode(e *encoder) {
+	PointsZM(lr).encode(e)
+}
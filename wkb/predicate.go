@@ -0,0 +1,345 @@
+package wkb
+
+import (
+	"math"
+)
+
+// Predicates are planar, computed from X and Y only, with semantics of ST_Relate.
+// Curves are linearized with DefaultSegments.
+// Rings of polygons are always on boundary, so point shared by two polygons of multi polygon is on boundary.
+// Endpoint of curve lies on boundary when it is endpoint of odd number of curves (mod-2 rule),
+// so endpoint shared by two lines of multi line string is in interior.
+
+type location int
+
+const (
+	interior location = iota
+	boundary
+	exterior
+)
+
+// Contains reports whether no point of b lies in exterior of a and their interiors intersect, like ST_Contains.
+func Contains(a, b Geometry) bool {
+	if p, ok := singlePoint(b); ok {
+		return locate(a, p) == interior
+	}
+	m := Relate(a, b)
+	return m[interior][interior] >= 0 && m[exterior][interior] < 0 && m[exterior][boundary] < 0
+}
+
+// Within reports whether a lies within b, like ST_Within.
+func Within(a, b Geometry) bool {
+	return Contains(b, a)
+}
+
+// Covers reports whether no point of b lies in exterior of a, like ST_Covers.
+// Unlike Contains, b lying entirely on boundary of a is covered.
+func Covers(a, b Geometry) bool {
+	if p, ok := singlePoint(b); ok {
+		return locate(a, p) != exterior
+	}
+	m := Relate(a, b)
+	return m.intersects() && m[exterior][interior] < 0 && m[exterior][boundary] < 0
+}
+
+// Intersects reports whether geometries have any point in common, like ST_Intersects.
+func Intersects(a, b Geometry) bool {
+	if p, ok := singlePoint(b); ok {
+		return locate(a, p) != exterior
+	}
+	if p, ok := singlePoint(a); ok {
+		return locate(b, p) != exterior
+	}
+	m := Relate(a, b)
+	return m.intersects()
+}
+
+// Disjoint reports whether geometries have no point in common, like ST_Disjoint.
+func Disjoint(a, b Geometry) bool {
+	return !Intersects(a, b)
+}
+
+// Touches reports whether geometries have common points only on their boundaries, like ST_Touches.
+func Touches(a, b Geometry) bool {
+	if p, ok := singlePoint(b); ok {
+		return locate(a, p) == boundary
+	}
+	if p, ok := singlePoint(a); ok {
+		return locate(b, p) == boundary
+	}
+	m := Relate(a, b)
+	return m[interior][interior] < 0 && m.intersects()
+}
+
+func singlePoint(g Geometry) (Point, bool) {
+	p, ok := g.(Point)
+	return p, ok && !p.IsEmpty()
+}
+
+func locate(g Geometry, p Point) location {
+	return newShape(g).locate(p, nil)
+}
+
+type shaper interface {
+	addShape(s *shape)
+}
+
+// shape is geometry flattened into points, lines and polygons.
+type shape struct {
+	points   []Point
+	lines    []LineString
+	polygons []Polygon
+}
+
+func newShape(g Geometry) *shape {
+	s := &shape{}
+	s.geometry(g)
+	return s
+}
+
+func (s *shape) geometry(g Geometry) {
+	switch g := g.(type) {
+	case shaper:
+		g.addShape(s)
+	case View:
+		if d, err := g.Decode(); err == nil {
+			s.geometry(d)
+		}
+	case EWKB:
+		s.geometry(g.Geometry)
+	case Spatialite:
+		s.geometry(g.Geometry)
+	case GeoPackage:
+		s.geometry(g.Geometry)
+	case Geom:
+		s.geometry(g.Geometry)
+	}
+}
+
+func (s *shape) all(gs []Geometry) {
+	for _, g := range gs {
+		s.geometry(g)
+	}
+}
+
+func (s *shape) point(p Point) {
+	if !p.IsEmpty() {
+		s.points = append(s.points, p)
+	}
+}
+
+// line adds line string, or its only point when it has zero length.
+func (s *shape) line(ls LineString) {
+	if len(ls) == 0 {
+		return
+	}
+	for _, p := range ls[1:] {
+		if p != ls[0] {
+			s.lines = append(s.lines, ls)
+			return
+		}
+	}
+	s.point(ls[0])
+}
+
+func (s *shape) polygon(p Polygon) {
+	if len(p) > 0 && len(p[0]) > 0 {
+		s.polygons = append(s.polygons, p)
+	}
+}
+
+// project returns points projected onto X and Y.
+func project[T coord](pts []T) []Point {
+	p := make([]Point, len(pts))
+	for i, pt := range pts {
+		p[i].X, p[i].Y = pt.xy()
+	}
+	return p
+}
+
+func projectPolygon[R ~[]T, T coord](rings []R) Polygon {
+	p := make(Polygon, len(rings))
+	for i, r := range rings {
+		p[i] = project(r)
+	}
+	return p
+}
+
+func (s *shape) envelope() Envelope {
+	e := envelope(s.points)
+	for _, ls := range s.lines {
+		e = e.Union(ls.Envelope())
+	}
+	for _, p := range s.polygons {
+		e = e.Union(p.Envelope())
+	}
+	return e
+}
+
+// dimension returns dimension of interior, -1 when shape is empty.
+func (s *shape) dimension() int {
+	switch {
+	case len(s.polygons) > 0:
+		return 2
+	case len(s.lines) > 0:
+		return 1
+	case len(s.points) > 0:
+		return 0
+	default:
+		return -1
+	}
+}
+
+// boundaryDimension returns dimension of boundary, -1 when it is empty.
+func (s *shape) boundaryDimension() int {
+	if len(s.polygons) > 0 {
+		return 1
+	}
+	for _, ls := range s.lines {
+		if s.locate(ls[0], nil) == boundary || s.locate(ls[len(ls)-1], nil) == boundary {
+			return 0
+		}
+	}
+	return -1
+}
+
+// locate returns location of point in shape. Point is known to lie on segment on, when it is not nil.
+func (s *shape) locate(p Point, on *segment) location {
+	in, rings := s.locatePolygons(p, on)
+	if rings > 0 {
+		return boundary
+	}
+	nb := 0
+	for _, q := range s.points {
+		if q == p {
+			in = true
+		}
+	}
+	for i, ls := range s.lines {
+		first, last := ls[0], ls[len(ls)-1]
+		switch {
+		case first != last && (p == first || p == last):
+			nb++
+		case on != nil && on.line == i, onLine(ls, p):
+			in = true
+		}
+	}
+	return combine(in, nb)
+}
+
+// locatePolygons returns whether point lies in interior of any polygon and on boundary of how many of them.
+func (s *shape) locatePolygons(p Point, on *segment) (in bool, nb int) {
+	for i, pg := range s.polygons {
+		loc := boundary
+		if on == nil || on.poly != i {
+			loc = locatePolygon(pg, p)
+		}
+		switch loc {
+		case interior:
+			in = true
+		case boundary:
+			nb++
+		}
+	}
+	return in, nb
+}
+
+func combine(in bool, nb int) location {
+	switch {
+	case nb%2 == 1:
+		return boundary
+	case in || nb > 0:
+		return interior
+	default:
+		return exterior
+	}
+}
+
+// sides returns location in polygons of shape of area left and right of point lying on segment dir.
+// Point is known to lie on segment on, when it is not nil.
+func (s *shape) sides(p Point, on, dir *segment) (left, right location) {
+	// edge shared by even number of polygons has their interior on both sides
+	in, nb := s.locatePolygons(p, on)
+	switch loc := combine(in, nb); {
+	case loc == boundary && on != nil && on.poly >= 0:
+		// area on one side of ring edge is inside polygon
+		if on.left == (dot(on, dir) > 0) {
+			return interior, exterior
+		}
+		return exterior, interior
+	case loc == boundary:
+		return boundary, boundary
+	default:
+		return loc, loc
+	}
+}
+
+func locatePolygon(p Polygon, pt Point) location {
+	switch locateRing(p[0], pt) {
+	case exterior:
+		return exterior
+	case boundary:
+		return boundary
+	}
+	for _, h := range p[1:] {
+		switch locateRing(h, pt) {
+		case interior:
+			return exterior
+		case boundary:
+			return boundary
+		}
+	}
+	return interior
+}
+
+// locateRing returns location of point in closed ring by counting crossings of ray cast from point.
+func locateRing(r LinearRing, p Point) location {
+	in := false
+	for i := 1; i < len(r); i++ {
+		a, b := r[i-1], r[i]
+		if onSegment(a, b, p) {
+			return boundary
+		}
+		if (a.Y > p.Y) != (b.Y > p.Y) && (orient(a, b, p) > 0) == (b.Y > a.Y) {
+			in = !in
+		}
+	}
+	if in {
+		return interior
+	}
+	return exterior
+}
+
+func onLine(ls LineString, p Point) bool {
+	for i := 1; i < len(ls); i++ {
+		if onSegment(ls[i-1], ls[i], p) {
+			return true
+		}
+	}
+	return false
+}
+
+func onSegment(a, b, p Point) bool {
+	return orient(a, b, p) == 0 && between(a, b, p)
+}
+
+// between reports whether point lies within bounding box of segment.
+func between(a, b, p Point) bool {
+	return math.Min(a.X, b.X) <= p.X && p.X <= math.Max(a.X, b.X) &&
+		math.Min(a.Y, b.Y) <= p.Y && p.Y <= math.Max(a.Y, b.Y)
+}
+
+// orient returns positive value when p lies left of line through a and b, negative when right and 0 when on it.
+func orient(a, b, p Point) float64 {
+	return (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+}
+
+// signedArea returns area of ring, positive when it is counter-clockwise.
+func signedArea(r LinearRing) float64 {
+	a2 := 0.0
+	for i := 1; i < len(r); i++ {
+		a2 += (r[i-1].X - r[0].X) * (r[i].Y - r[0].Y)
+		a2 -= (r[i].X - r[0].X) * (r[i-1].Y - r[0].Y)
+	}
+	return a2 / 2
+}
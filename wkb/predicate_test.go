@@ -0,0 +1,170 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredicates(t *testing.T) {
+	square := Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	holed := Polygon{square[0], {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}}
+
+	type result struct {
+		contains, covers, intersects, touches bool
+	}
+	valid := []struct {
+		a, b     Geometry
+		expected result
+	}{
+		// point in polygon
+		{square, Point{5, 5}, result{true, true, true, false}},
+		{square, Point{10, 5}, result{false, true, true, true}},
+		{square, Point{0, 0}, result{false, true, true, true}},
+		{square, Point{11, 5}, result{false, false, false, false}},
+		{holed, Point{3, 3}, result{false, false, false, false}},
+		{holed, Point{2, 3}, result{false, true, true, true}},
+		{holed, Point{5, 5}, result{true, true, true, false}},
+		{MultiPolygon{holed, {{{20, 0}, {30, 0}, {30, 10}, {20, 0}}}}, Point{25, 1}, result{true, true, true, false}},
+		// point shared by two polygons is on boundary of multi polygon
+		{MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}, {{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}}}, Point{1, 1}, result{false, true, true, true}},
+		{square, MultiPoint{{1, 1}, {10, 10}}, result{true, true, true, false}},
+		{square, MultiPoint{{0, 0}, {10, 10}}, result{false, true, true, true}},
+		{square, MultiPoint{{1, 1}, {20, 20}}, result{false, false, true, false}},
+		{square, MultiPoint{{10, 5}}, result{false, true, true, true}},
+
+		// point and line
+		{LineString{{0, 0}, {10, 0}}, Point{5, 0}, result{true, true, true, false}},
+		{LineString{{0, 0}, {10, 0}}, Point{10, 0}, result{false, true, true, true}},
+		{LineString{{0, 0}, {10, 0}}, Point{5, 1}, result{false, false, false, false}},
+		{LineString{{0, 0}, {10, 0}, {10, 10}, {0, 0}}, Point{0, 0}, result{true, true, true, false}},
+		// shared endpoint is not on boundary of multi line string by mod-2 rule
+		{MultiLineString{{{0, 0}, {5, 0}}, {{5, 0}, {10, 0}}}, Point{5, 0}, result{true, true, true, false}},
+		{Point{5, 0}, LineString{{0, 0}, {10, 0}}, result{false, false, true, false}},
+		{Point{0, 0}, LineString{{0, 0}, {10, 0}}, result{false, false, true, true}},
+		{Point{1, 1}, Point{1, 1}, result{true, true, true, false}},
+		{MultiPoint{{1, 1}, {2, 2}}, Point{2, 2}, result{true, true, true, false}},
+
+		// line and polygon
+		{square, LineString{{1, 1}, {9, 9}}, result{true, true, true, false}},
+		{square, LineString{{0, 0}, {10, 10}}, result{true, true, true, false}},
+		{square, LineString{{0, 0}, {10, 0}}, result{false, true, true, true}},
+		{square, LineString{{5, 5}, {15, 5}}, result{false, false, true, false}},
+		{square, LineString{{10, 0}, {20, 0}}, result{false, false, true, true}},
+		{square, LineString{{-5, 5}, {15, 5}}, result{false, false, true, false}},
+		{holed, LineString{{1, 3}, {5, 3}}, result{false, false, true, false}},
+		{holed, LineString{{1, 1}, {5, 1}, {5, 5}}, result{true, true, true, false}},
+		{holed, LineString{{2, 2}, {2, 4}}, result{false, true, true, true}},
+		{LineString{{5, 5}, {15, 5}}, square, result{false, false, true, false}},
+
+		// line and line
+		{LineString{{0, 0}, {10, 10}}, LineString{{0, 10}, {10, 0}}, result{false, false, true, false}},
+		{LineString{{0, 0}, {10, 0}}, LineString{{2, 0}, {5, 0}}, result{true, true, true, false}},
+		{LineString{{0, 0}, {10, 0}}, LineString{{0, 0}, {5, 0}}, result{true, true, true, false}},
+		{LineString{{0, 0}, {10, 0}}, LineString{{5, 0}, {15, 0}}, result{false, false, true, false}},
+		{LineString{{0, 0}, {10, 0}}, LineString{{10, 0}, {10, 5}}, result{false, false, true, true}},
+		{LineString{{0, 0}, {10, 0}}, LineString{{5, 0}, {5, 5}}, result{false, false, true, true}},
+		{LineString{{0, 0}, {10, 0}}, LineString{{0, 1}, {10, 1}}, result{false, false, false, false}},
+		{LineString{{0, 0}, {5, 0}, {10, 0}}, LineString{{10, 0}, {0, 0}}, result{true, true, true, false}},
+		{MultiLineString{{{0, 0}, {5, 0}}, {{5, 0}, {10, 0}}}, LineString{{2, 0}, {8, 0}}, result{true, true, true, false}},
+
+		// polygon and polygon
+		{square, square, result{true, true, true, false}},
+		{square, Polygon{{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}}, result{true, true, true, false}},
+		{square, Polygon{{{1, 1}, {9, 1}, {9, 9}, {1, 1}}}, result{true, true, true, false}},
+		{square, Polygon{{{0, 0}, {5, 0}, {5, 5}, {0, 0}}}, result{true, true, true, false}},
+		{square, Polygon{{{5, 5}, {15, 5}, {15, 15}, {5, 5}}}, result{false, false, true, false}},
+		{square, Polygon{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}}, result{false, false, true, true}},
+		{square, Polygon{{{10, 10}, {20, 10}, {20, 20}, {10, 10}}}, result{false, false, true, true}},
+		{square, Polygon{{{11, 0}, {20, 0}, {20, 10}, {11, 0}}}, result{false, false, false, false}},
+		{holed, Polygon{{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}}, result{false, false, true, true}},
+		{holed, Polygon{{{1, 1}, {5, 1}, {5, 5}, {1, 5}, {1, 1}}}, result{false, false, true, false}},
+		{holed, Polygon{{{5, 5}, {9, 5}, {9, 9}, {5, 5}}}, result{true, true, true, false}},
+		{Polygon{{{1, 1}, {5, 1}, {5, 5}, {1, 5}, {1, 1}}}, holed, result{false, false, true, false}},
+		{square, Polygon{{{-5, -5}, {15, -5}, {15, 15}, {-5, 15}, {-5, -5}}}, result{false, false, true, false}},
+		// polygon inside hole of other polygon
+		{holed, Polygon{{{2.5, 2.5}, {3.5, 2.5}, {3.5, 3.5}, {2.5, 2.5}}}, result{false, false, false, false}},
+		{MultiPolygon{{{{0, 0}, {5, 0}, {5, 10}, {0, 10}, {0, 0}}}, {{{5, 0}, {10, 0}, {10, 10}, {5, 10}, {5, 0}}}}, square, result{true, true, true, false}},
+
+		// collections and wrappers
+		{GeometryCollection{square, Point{20, 20}}, Point{20, 20}, result{true, true, true, false}},
+		{square, GeometryCollection{Point{1, 1}, LineString{{2, 2}, {3, 3}}}, result{true, true, true, false}},
+		{EWKB{4326, square}, EWKB{4326, Point{5, 5}}, result{true, true, true, false}},
+		{View(Marshal(square)), LineString{{0, 0}, {10, 0}}, result{false, true, true, true}},
+
+		// other kinds by their projection onto X and Y
+		{PolygonZ{{{0, 0, 1}, {10, 0, 2}, {10, 10, 3}, {0, 10, 4}, {0, 0, 1}}}, Point{5, 5}, result{true, true, true, false}},
+		{PolygonZ{{{0, 0, 1}, {10, 0, 2}, {10, 10, 3}, {0, 10, 4}, {0, 0, 1}}}, PointZ{10, 5, 0}, result{false, true, true, true}},
+		{square, LineStringM{{5, 5, 1}, {15, 5, 2}}, result{false, false, true, false}},
+		{MultiPolygonZM{{{{0, 0, 0, 0}, {10, 0, 0, 0}, {10, 10, 0, 0}, {0, 10, 0, 0}, {0, 0, 0, 0}}}}, MultiPointZ{{1, 1, 1}}, result{true, true, true, false}},
+		{Triangle{{{0, 0}, {10, 0}, {0, 10}, {0, 0}}}, Point{1, 1}, result{true, true, true, false}},
+		{PolyhedralSurfaceZ{{{{0, 0, 0}, {10, 0, 0}, {10, 10, 0}, {0, 10, 0}, {0, 0, 0}}}}, square, result{true, true, true, false}},
+		{CurvePolygon{CircularString{{-1, 0}, {0, 1}, {1, 0}, {0, -1}, {-1, 0}}}, Point{0, 0}, result{true, true, true, false}},
+		{CircularString{{-1, 0}, {0, 1}, {1, 0}}, LineString{{0, 0}, {0, 2}}, result{false, false, true, false}},
+		{GeometryCollectionZ{PointZ{20, 20, 1}}, Point{20, 20}, result{true, true, true, false}},
+
+		// empty geometries
+		{square, EmptyPoint(), result{false, false, false, false}},
+		{square, Polygon{}, result{false, false, false, false}},
+		{GeometryCollection{}, square, result{false, false, false, false}},
+	}
+
+	for _, e := range valid {
+		assert.Equal(t, e.expected.contains, Contains(e.a, e.b), "Contains(%#v, %#v)", e.a, e.b)
+		assert.Equal(t, e.expected.contains, Within(e.b, e.a), "Within(%#v, %#v)", e.b, e.a)
+		assert.Equal(t, e.expected.covers, Covers(e.a, e.b), "Covers(%#v, %#v)", e.a, e.b)
+		assert.Equal(t, e.expected.intersects, Intersects(e.a, e.b), "Intersects(%#v, %#v)", e.a, e.b)
+		assert.Equal(t, e.expected.intersects, Intersects(e.b, e.a), "Intersects(%#v, %#v)", e.b, e.a)
+		assert.Equal(t, !e.expected.intersects, Disjoint(e.a, e.b), "Disjoint(%#v, %#v)", e.a, e.b)
+		assert.Equal(t, e.expected.touches, Touches(e.a, e.b), "Touches(%#v, %#v)", e.a, e.b)
+		assert.Equal(t, e.expected.touches, Touches(e.b, e.a), "Touches(%#v, %#v)", e.b, e.a)
+	}
+}
+
+func TestRelateMatrix(t *testing.T) {
+	valid := []struct {
+		a, b     Geometry
+		expected IntersectionMatrix
+	}{
+		{
+			Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			Polygon{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}},
+			IntersectionMatrix{{2, 1, 2}, {1, 0, 1}, {2, 1, 2}},
+		},
+		{
+			LineString{{0, 0}, {10, 10}},
+			LineString{{0, 10}, {10, 0}},
+			IntersectionMatrix{{0, -1, 1}, {-1, -1, 0}, {1, 0, 2}},
+		},
+		{
+			Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			LineString{{-5, 5}, {5, 5}},
+			IntersectionMatrix{{1, 0, 2}, {0, -1, 1}, {1, 0, 2}},
+		},
+		{
+			Point{0, 0},
+			LineString{{0, 0}, {1, 1}},
+			IntersectionMatrix{{-1, 0, -1}, {-1, -1, -1}, {1, 0, 2}},
+		},
+		{
+			MultiPoint{{0, 0}, {1, 1}},
+			Point{5, 5},
+			IntersectionMatrix{{-1, -1, 0}, {-1, -1, -1}, {0, -1, 2}},
+		},
+	}
+
+	for _, e := range valid {
+		assert.Equal(t, e.expected, Relate(e.a, e.b), "Relate(%#v, %#v)", e.a, e.b)
+	}
+}
+
+func BenchmarkContainsPoint(b *testing.B) {
+	zone := Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}}
+	p := Point{5, 5}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !Contains(zone, p) {
+			b.Fatal("expected zone to contain point")
+		}
+	}
+}
@@ -1,9 +1,9 @@
 package wkb
 
 import (
-	"bytes"
 	"encoding/binary"
 	"math"
+	"unsafe"
 )
 
 func readUint32(b []byte, dec binary.ByteOrder) ([]byte, uint32) {
@@ -15,34 +15,80 @@ func readCount(b []byte, dec binary.ByteOrder) ([]byte, int) {
 	return b, int(n)
 }
 
-func writeCount(buf *bytes.Buffer, n int) {
-	b := [CountSize]byte{}
-	binary.LittleEndian.PutUint32(b[:], uint32(n))
-	buf.Write(b[:])
+func (e *encoder) count(n int) {
+	e.buf = e.order.AppendUint32(e.buf, uint32(n))
 }
 
+const nanBits = 0x7ff8000000000000
+
 func readFloat64(b []byte, dec binary.ByteOrder) ([]byte, float64) {
 	return b[Float64Size:], math.Float64frombits(dec.Uint64(b))
 }
 
-func writeFloat64(buf *bytes.Buffer, f float64) {
-	b := [Float64Size]byte{}
-	binary.LittleEndian.PutUint64(b[:], math.Float64bits(f))
-	buf.Write(b[:])
+// nativeLittleEndian reports whether host stores numbers in little-endian byte order.
+var nativeLittleEndian = binary.NativeEndian.Uint16([]byte{1, 0}) == 1
+
+// readCoords decodes coordinates into dst viewed as array of float64 ordinates.
+// Little-endian input on little-endian host is copied at once.
+func readCoords[T Point | PointZ | PointM | PointZM](b []byte, dec binary.ByteOrder, dst []T) []byte {
+	if len(dst) == 0 {
+		return b
+	}
+
+	var zero T
+	size := len(dst) * int(unsafe.Sizeof(zero))
+	if dec == binary.LittleEndian && nativeLittleEndian {
+		copy(unsafe.Slice((*byte)(unsafe.Pointer(&dst[0])), size), b)
+		return b[size:]
+	}
+
+	fs := unsafe.Slice((*float64)(unsafe.Pointer(&dst[0])), size/Float64Size)
+	if dec == binary.LittleEndian {
+		for i := range fs {
+			fs[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[i*Float64Size:]))
+		}
+	} else {
+		for i := range fs {
+			fs[i] = math.Float64frombits(binary.BigEndian.Uint64(b[i*Float64Size:]))
+		}
+	}
+	return b[size:]
 }
 
-func header(b []byte, tpe Kind) ([]byte, binary.ByteOrder, error) {
-	dec := byteOrder(b[0])
-	if dec == nil {
-		return nil, nil, ErrUnsupportedValue
+// float64 writes NaN in canonical form used by GEOS and PostGIS for empty points.
+func (e *encoder) float64(f float64) {
+	bits := math.Float64bits(f)
+	if f != f {
+		bits = nanBits
 	}
 
-	b, kind := readUint32(b[ByteOrderSize:], dec)
-	if tpe != Kind(kind) {
-		return nil, nil, ErrUnsupportedValue
+	e.buf = e.order.AppendUint64(e.buf, bits)
+}
+
+// kind maps type code to ISO kind, translating EWKB dimension flags.
+func kind(code uint32) Kind {
+	k := Kind(code &^ (ewkbZ | ewkbM | ewkbSRID))
+	if code&ewkbZ != 0 {
+		k += 1000
 	}
+	if code&ewkbM != 0 {
+		k += 2000
+	}
+	return k
+}
 
-	return b, dec, nil
+// ewkbCode maps ISO kind to type code with EWKB dimension flags.
+func ewkbCode(k Kind) uint32 {
+	code := uint32(k % 1000)
+	switch k / 1000 {
+	case 1:
+		code |= ewkbZ
+	case 2:
+		code |= ewkbM
+	case 3:
+		code |= ewkbZ | ewkbM
+	}
+	return code
 }
 
 func byteOrder(b byte) binary.ByteOrder {
@@ -56,9 +102,6 @@ func byteOrder(b byte) binary.ByteOrder {
 	}
 }
 
-func writeHeader(buf *bytes.Buffer, tpe Kind) {
-	b := [HeaderSize]byte{}
-	b[0] = LittleEndian
-	binary.LittleEndian.PutUint32(b[ByteOrderSize:], uint32(tpe))
-	buf.Write(b[:])
+func (e *encoder) header(tpe Kind) {
+	e.buf = e.order.AppendUint32(append(e.buf, e.flag), uint32(tpe))
 }
@@ -2,6 +2,7 @@ package wkb
 
 import (
 	"encoding/binary"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,13 +14,13 @@ func TestHeader(t *testing.T) {
 		ErrUnsupportedValue: {0x01, 0x02, 0x00, 0x00, 0x00},
 	}
 	for expected, b := range invalid {
-		if _, _, err := header(b, GeomPoint); assert.Error(t, err) {
-			assert.Exactly(t, expected, err)
+		if _, _, err := newDecoder(b).header(b, GeomPoint); assert.Error(t, err) {
+			assert.ErrorIs(t, err, expected)
 		}
 	}
 
 	valid := []byte{0x01, 0x01, 0x00, 0x00, 0x00}
-	if b, bo, err := header(valid, GeomPoint); assert.NoError(t, err) {
+	if b, bo, err := newDecoder(valid).header(valid, GeomPoint); assert.NoError(t, err) {
 		assert.Len(t, b, 0)
 		assert.Exactly(t, binary.LittleEndian, bo)
 	}
@@ -30,3 +31,48 @@ func TestByteOrder(t *testing.T) {
 	assert.Exactly(t, binary.LittleEndian, byteOrder(0x01))
 	assert.Nil(t, byteOrder(0x42))
 }
+
+func TestKind(t *testing.T) {
+	codes := map[uint32]Kind{
+		0x00000001: GeomPoint,
+		0x000003e9: GeomPointZ,
+		0x80000002: GeomLineStringZ,
+		0x40000003: GeomPolygonM,
+		0xc0000007: GeomCollectionZM,
+		0x20000001: GeomPoint,
+		0xa0000004: GeomMultiPointZ,
+	}
+	for code, expected := range codes {
+		assert.Equal(t, expected, kind(code), "Expected code <%x> to map to %d", code, expected)
+		assert.Equal(t, expected, kind(ewkbCode(expected)))
+	}
+}
+
+func TestReadCoords(t *testing.T) {
+	expected := PointsZM{{30, 10, 1, 2}, {10, 30, 3, 4}, {40, 40, 5, 6}}
+	b := []byte{}
+	for _, p := range expected {
+		for _, f := range []float64{p.X, p.Y, p.Z, p.M} {
+			b = binary.BigEndian.AppendUint64(b, math.Float64bits(f))
+		}
+	}
+
+	actual := make(PointsZM, 3)
+	assert.Equal(t, []byte{0x42}, readCoords(append(b, 0x42), binary.BigEndian, actual))
+	assert.Equal(t, expected, actual)
+
+	defer func(native bool) {
+		nativeLittleEndian = native
+	}(nativeLittleEndian)
+	for _, native := range []bool{true, false} {
+		nativeLittleEndian = native
+		e := newEncoder(nil)
+		expected.encode(e)
+
+		actual := make(PointsZM, 3)
+		assert.Empty(t, readCoords(e.buf[CountSize:], binary.LittleEndian, actual))
+		assert.Equal(t, expected, actual)
+	}
+
+	assert.Equal(t, []byte{0x42}, readCoords([]byte{0x42}, binary.LittleEndian, []Point{}))
+}
@@ -0,0 +1,294 @@
+package wkb
+
+import (
+	"math"
+	"sort"
+)
+
+// IntersectionMatrix is DE-9IM matrix holding dimensions of intersections of interior, boundary and exterior
+// of first geometry (rows) with those of second geometry (columns), -1 when intersection is empty.
+type IntersectionMatrix [3][3]int
+
+// Relate returns intersection matrix of geometries, like ST_Relate.
+// Curves are linearized with DefaultSegments, Z and M are ignored.
+func Relate(a, b Geometry) IntersectionMatrix {
+	return newShape(a).relate(newShape(b))
+}
+
+// String returns matrix in row order, with F for empty intersection and dimension otherwise, like "212101212".
+func (m IntersectionMatrix) String() string {
+	b := make([]byte, 0, 9)
+	for _, row := range m {
+		for _, dim := range row {
+			if dim < 0 {
+				b = append(b, 'F')
+			} else {
+				b = append(b, byte('0'+dim))
+			}
+		}
+	}
+	return string(b)
+}
+
+// Matches reports whether matrix matches pattern of 9 characters in row order, like "T*F**F***".
+// T matches non-empty intersection, F empty one, * any and 0, 1 or 2 intersection of that dimension.
+// Malformed pattern matches nothing.
+func (m IntersectionMatrix) Matches(pattern string) bool {
+	if len(pattern) != 9 {
+		return false
+	}
+	for i, c := range []byte(pattern) {
+		dim := m[i/3][i%3]
+		switch c {
+		case '*':
+		case 'T', 't':
+			if dim < 0 {
+				return false
+			}
+		case 'F', 'f':
+			if dim >= 0 {
+				return false
+			}
+		case '0', '1', '2':
+			if dim != int(c-'0') {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (m *IntersectionMatrix) set(a, b location, dim int) {
+	m[a][b] = max(m[a][b], dim)
+}
+
+func (m *IntersectionMatrix) intersects() bool {
+	return m[interior][interior] >= 0 || m[interior][boundary] >= 0 ||
+		m[boundary][interior] >= 0 || m[boundary][boundary] >= 0
+}
+
+// segment is edge of line or polygon ring, left is set when polygon lies left of ring edge.
+type segment struct {
+	p0, p1     Point
+	line, poly int
+	left       bool
+}
+
+func (s *shape) segments() []segment {
+	var segs []segment
+	for i, ls := range s.lines {
+		for j := 1; j < len(ls); j++ {
+			if ls[j-1] != ls[j] {
+				segs = append(segs, segment{ls[j-1], ls[j], i, -1, false})
+			}
+		}
+	}
+	for i, p := range s.polygons {
+		for k, r := range p {
+			// interior lies left of counter-clockwise shell and right of counter-clockwise holes
+			left := (signedArea(r) > 0) == (k == 0)
+			for j := 1; j < len(r); j++ {
+				if r[j-1] != r[j] {
+					segs = append(segs, segment{r[j-1], r[j], -1, i, left})
+				}
+			}
+		}
+	}
+	return segs
+}
+
+// param returns position of point along segment, scaled by squared length of segment.
+func (s *segment) param(p Point) float64 {
+	return (p.X-s.p0.X)*(s.p1.X-s.p0.X) + (p.Y-s.p0.Y)*(s.p1.Y-s.p0.Y)
+}
+
+func dot(s, t *segment) float64 {
+	return (s.p1.X-s.p0.X)*(t.p1.X-t.p0.X) + (s.p1.Y-s.p0.Y)*(t.p1.Y-t.p0.Y)
+}
+
+// overlap is part of segment between p0 and p1 shared with segment seg of other shape.
+type overlap struct {
+	p0, p1 Point
+	seg    int
+}
+
+// node is point where shapes may change location, with segments it is known to lie on.
+type node struct {
+	a, b *segment
+}
+
+// relate computes intersection matrix by splitting segments of both shapes at their intersections,
+// then locating nodes and pieces of segments between them in both shapes.
+func (s *shape) relate(other *shape) IntersectionMatrix {
+	m := IntersectionMatrix{{-1, -1, -1}, {-1, -1, -1}, {-1, -1, 2}}
+	if !s.envelope().Intersects(other.envelope()) {
+		m[interior][exterior] = s.dimension()
+		m[boundary][exterior] = s.boundaryDimension()
+		m[exterior][interior] = other.dimension()
+		m[exterior][boundary] = other.boundaryDimension()
+		return m
+	}
+
+	segsA, segsB := s.segments(), other.segments()
+	splitsA, splitsB := make([][]Point, len(segsA)), make([][]Point, len(segsB))
+	overlapsA, overlapsB := make([][]overlap, len(segsA)), make([][]overlap, len(segsB))
+	nodes := map[Point]*node{}
+	addNode := func(p Point, a, b *segment) {
+		n, ok := nodes[p]
+		if !ok {
+			n = &node{}
+			nodes[p] = n
+		}
+		if a != nil {
+			n.a = a
+		}
+		if b != nil {
+			n.b = b
+		}
+	}
+
+	for i := range segsA {
+		sa := &segsA[i]
+		for j := range segsB {
+			sb := &segsB[j]
+			pts, collinear := intersectSegments(sa.p0, sa.p1, sb.p0, sb.p1)
+			for _, p := range pts {
+				addNode(p, sa, sb)
+				splitsA[i] = append(splitsA[i], p)
+				splitsB[j] = append(splitsB[j], p)
+			}
+			if collinear {
+				overlapsA[i] = append(overlapsA[i], overlap{pts[0], pts[1], j})
+				overlapsB[j] = append(overlapsB[j], overlap{pts[0], pts[1], i})
+			}
+		}
+	}
+
+	for i := range segsA {
+		addNode(segsA[i].p0, &segsA[i], nil)
+		addNode(segsA[i].p1, &segsA[i], nil)
+	}
+	for i := range segsB {
+		addNode(segsB[i].p0, nil, &segsB[i])
+		addNode(segsB[i].p1, nil, &segsB[i])
+	}
+	// points of other shape lying on segment split it, so that its pieces are not located at them
+	for _, p := range s.points {
+		addNode(p, nil, nil)
+		for j := range segsB {
+			if onSegment(segsB[j].p0, segsB[j].p1, p) {
+				addNode(p, nil, &segsB[j])
+				splitsB[j] = append(splitsB[j], p)
+			}
+		}
+	}
+	for _, p := range other.points {
+		addNode(p, nil, nil)
+		for i := range segsA {
+			if onSegment(segsA[i].p0, segsA[i].p1, p) {
+				addNode(p, &segsA[i], nil)
+				splitsA[i] = append(splitsA[i], p)
+			}
+		}
+	}
+	for p, n := range nodes {
+		m.set(s.locate(p, n.a), other.locate(p, n.b), 0)
+	}
+
+	relatePieces(&m, s, other, segsA, segsB, splitsA, overlapsA, false)
+	relatePieces(&m, other, s, segsB, segsA, splitsB, overlapsB, true)
+	return m
+}
+
+// relatePieces adds pieces of segments of a, split at nodes, located in a and b to matrix.
+// Pieces of polygon rings also add location of areas on their sides.
+func relatePieces(m *IntersectionMatrix, a, b *shape, segs, others []segment, splits [][]Point, overlaps [][]overlap, transpose bool) {
+	set := func(la, lb location, dim int) {
+		if transpose {
+			la, lb = lb, la
+		}
+		m.set(la, lb, dim)
+	}
+
+	for i := range segs {
+		seg := &segs[i]
+		pts := append(splits[i], seg.p0, seg.p1)
+		sort.Slice(pts, func(j, k int) bool {
+			return seg.param(pts[j]) < seg.param(pts[k])
+		})
+
+		for k := 1; k < len(pts); k++ {
+			if pts[k-1] == pts[k] {
+				continue
+			}
+			mid := Point{(pts[k-1].X + pts[k].X) / 2, (pts[k-1].Y + pts[k].Y) / 2}
+
+			var on *segment
+			t := seg.param(mid)
+			for _, o := range overlaps[i] {
+				t0, t1 := seg.param(o.p0), seg.param(o.p1)
+				if math.Min(t0, t1) < t && t < math.Max(t0, t1) {
+					on = &others[o.seg]
+					break
+				}
+			}
+
+			set(a.locate(mid, seg), b.locate(mid, on), 1)
+			if seg.poly < 0 {
+				continue
+			}
+
+			al, ar := a.sides(mid, seg, seg)
+			bl, br := b.sides(mid, on, seg)
+			if al != boundary && bl != boundary {
+				set(al, bl, 2)
+			}
+			if ar != boundary && br != boundary {
+				set(ar, br, 2)
+			}
+		}
+	}
+}
+
+// intersectSegments returns intersection of segments p and q, with its two endpoints when segments are collinear
+// and overlap. Endpoints of segments lying on other segment are returned exactly.
+func intersectSegments(p0, p1, q0, q1 Point) (pts []Point, collinear bool) {
+	if math.Max(p0.X, p1.X) < math.Min(q0.X, q1.X) || math.Max(q0.X, q1.X) < math.Min(p0.X, p1.X) ||
+		math.Max(p0.Y, p1.Y) < math.Min(q0.Y, q1.Y) || math.Max(q0.Y, q1.Y) < math.Min(p0.Y, p1.Y) {
+		return nil, false
+	}
+
+	d0, d1 := orient(q0, q1, p0), orient(q0, q1, p1)
+	d2, d3 := orient(p0, p1, q0), orient(p0, p1, q1)
+
+	if d0 == 0 && d1 == 0 {
+		for _, c := range []struct {
+			a, b, p Point
+		}{{q0, q1, p0}, {q0, q1, p1}, {p0, p1, q0}, {p0, p1, q1}} {
+			// endpoints lying on other segment are endpoints of overlap
+			if between(c.a, c.b, c.p) && (len(pts) == 0 || pts[0] != c.p && pts[len(pts)-1] != c.p) {
+				pts = append(pts, c.p)
+			}
+		}
+		return pts, len(pts) == 2
+	}
+
+	if (d0 > 0) != (d1 > 0) && d0 != 0 && d1 != 0 && (d2 > 0) != (d3 > 0) && d2 != 0 && d3 != 0 {
+		t := d0 / (d0 - d1)
+		return []Point{{p0.X + t*(p1.X-p0.X), p0.Y + t*(p1.Y-p0.Y)}}, false
+	}
+
+	switch {
+	case d0 == 0 && between(q0, q1, p0):
+		return []Point{p0}, false
+	case d1 == 0 && between(q0, q1, p1):
+		return []Point{p1}, false
+	case d2 == 0 && between(p0, p1, q0):
+		return []Point{q0}, false
+	case d3 == 0 && between(p0, p1, q1):
+		return []Point{q1}, false
+	}
+	return nil, false
+}
@@ -0,0 +1,91 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelate(t *testing.T) {
+	square := Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	touching := MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}, {{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}}}
+
+	valid := []struct {
+		a, b     Geometry
+		expected string
+	}{
+		{square, Polygon{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}, "212101212"},
+		{square, square, "2FFF1FFF2"},
+		{square, Polygon{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}}, "FF2F11212"},
+		{square, Polygon{{{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}}, "212FF1FF2"},
+		{square, Polygon{{{20, 20}, {30, 20}, {30, 30}, {20, 20}}}, "FF2FF1212"},
+		{square, LineString{{-5, 5}, {5, 5}}, "1020F1102"},
+		{square, LineString{{0, 0}, {10, 0}}, "FF2101FF2"},
+		{square, Point{5, 5}, "0F2FF1FF2"},
+		{square, Point{10, 5}, "FF20F1FF2"},
+		{LineString{{0, 0}, {10, 10}}, LineString{{0, 10}, {10, 0}}, "0F1FF0102"},
+		{LineString{{0, 0}, {10, 0}}, LineString{{5, 0}, {15, 0}}, "1010F0102"},
+		{LineString{{0, 0}, {10, 0}, {10, 10}, {0, 0}}, LineString{{0, 0}, {5, 0}}, "101FFFFF2"},
+		{Point{0, 0}, LineString{{0, 0}, {1, 1}}, "F0FFFF102"},
+		{MultiPoint{{0, 0}, {1, 1}}, Point{5, 5}, "FF0FFF0F2"},
+		{MultiPoint{{0, 0}, {1, 1}}, Point{1, 1}, "0F0FFFFF2"},
+		{Point{1, 1}, EmptyPoint(), "FF0FFFFF2"},
+		{GeometryCollection{}, square, "FFFFFF212"},
+		{touching, Point{1, 1}, "FF20F1FF2"},
+		{touching, LineString{{0.5, 0.5}, {1.5, 1.5}}, "1020F1FF2"},
+
+		// every kind is related by its projection onto X and Y
+		{PolygonZ{{{0, 0, 1}, {10, 0, 2}, {10, 10, 3}, {0, 10, 4}, {0, 0, 1}}}, PointM{5, 5, 7}, "0F2FF1FF2"},
+		{LineStringZM{{0, 0, 1, 2}, {10, 10, 3, 4}}, MultiLineStringM{{{0, 10, 1}, {10, 0, 2}}}, "0F1FF0102"},
+		{MultiPolygonZ{{{{0, 0, 0}, {10, 0, 0}, {10, 10, 0}, {0, 10, 0}, {0, 0, 0}}}}, MultiPointZM{{5, 5, 0, 0}}, "0F2FF1FF2"},
+		{GeometryCollectionZ{PointZ{20, 20, 1}, PolygonZ{{{0, 0, 0}, {10, 0, 0}, {10, 10, 0}, {0, 10, 0}, {0, 0, 0}}}}, square, "2F0F1FFF2"},
+		{Triangle{{{0, 0}, {10, 0}, {0, 10}, {0, 0}}}, Point{1, 1}, "0F2FF1FF2"},
+		{TINZ{{{{0, 0, 0}, {10, 0, 0}, {0, 10, 0}, {0, 0, 0}}}}, Point{1, 1}, "0F2FF1FF2"},
+		{PolyhedralSurface{square}, square, "2FFF1FFF2"},
+		{CircularString{{-1, 0}, {0, 1}, {1, 0}}, LineString{{0, 0}, {0, 2}}, "0F1FF0102"},
+		{CurvePolygon{CircularString{{-1, 0}, {0, 1}, {1, 0}, {0, -1}, {-1, 0}}}, Point{0, 0}, "0F2FF1FF2"},
+		{MultiSurfaceM{PolygonM{{{0, 0, 1}, {10, 0, 1}, {10, 10, 1}, {0, 10, 1}, {0, 0, 1}}}}, square, "2FFF1FFF2"},
+		{CompoundCurveZ{LineStringZ{{0, 0, 0}, {10, 0, 0}}}, MultiCurve{LineString{{5, 0}, {15, 0}}}, "1010F0102"},
+		{Spatialite{4326, square}, View(Marshal(Point{5, 5})), "0F2FF1FF2"},
+	}
+
+	for _, e := range valid {
+		assert.Equal(t, e.expected, Relate(e.a, e.b).String(), "Relate(%#v, %#v)", e.a, e.b)
+		assert.True(t, Relate(e.a, e.b).Matches(e.expected), "Relate(%#v, %#v)", e.a, e.b)
+	}
+}
+
+func TestIntersectionMatrix(t *testing.T) {
+	m := IntersectionMatrix{{2, 1, 2}, {1, 0, 1}, {2, 1, 2}}
+	assert.Equal(t, "212101212", m.String())
+
+	valid := []struct {
+		pattern  string
+		expected bool
+	}{
+		{"212101212", true},
+		{"*********", true},
+		{"T*T***T**", true},
+		{"t*t***t**", true},
+		{"2********", true},
+		{"1********", false},
+		{"F********", false},
+		{"T*****FF*", false},
+		{"FF*FF****", false},
+		{"", false},
+		{"T*T***T*", false},
+		{"T*T***T***", false},
+		{"X********", false},
+	}
+
+	for _, e := range valid {
+		assert.Equal(t, e.expected, m.Matches(e.pattern), e.pattern)
+	}
+
+	// predicates expressed as patterns
+	square := Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	inner := Polygon{{{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}}
+	assert.True(t, Relate(square, inner).Matches("T*****FF*"))
+	assert.True(t, Relate(inner, square).Matches("T*F**F***"))
+	assert.False(t, Relate(square, inner).Matches("FF*FF****"))
+}
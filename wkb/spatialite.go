@@ -0,0 +1,166 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"math"
+)
+
+const (
+	spatialiteStart  = 0x00
+	spatialiteMBREnd = 0x7c
+	spatialiteEntity = 0x69
+	spatialiteEnd    = 0xfe
+)
+
+const (
+	spatialiteMBRSize = 4 * Float64Size
+	// start, byte order, srid and mbr preceding the mbr end marker
+	spatialitePrefixSize = 2*ByteOrderSize + SRIDSize + spatialiteMBRSize
+)
+
+// Spatialite is a geometry in SpatiaLite internal BLOB format,
+// as stored in geometry columns.
+type Spatialite struct {
+	SRID int
+	Geometry
+}
+
+func (s *Spatialite) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalBinary(b)
+}
+
+func (s Spatialite) Value() (driver.Value, error) {
+	return s.MarshalBinary()
+}
+
+func (s Spatialite) MarshalBinary() ([]byte, error) {
+	if s.Geometry == nil {
+		return nil, ErrUnsupportedValue
+	}
+	return s.AppendWKB(make([]byte, 0, s.ByteSize())), nil
+}
+
+func (s *Spatialite) UnmarshalBinary(b []byte) error {
+	_, tmp, err := ReadSpatialite(b)
+	if err != nil {
+		return err
+	}
+
+	*s = tmp
+	return nil
+}
+
+// ReadSpatialite decodes SpatiaLite BLOB geometry.
+// Compressed geometries are not supported.
+func ReadSpatialite(b []byte) ([]byte, Spatialite, error) {
+	s := Spatialite{}
+	if len(b) < spatialitePrefixSize+HeaderSize+ByteOrderSize {
+		return nil, s, ErrInvalidStorage
+	}
+
+	if b[0] != spatialiteStart || b[spatialitePrefixSize] != spatialiteMBREnd {
+		return nil, s, ErrInvalidStorage
+	}
+
+	dec := byteOrder(b[ByteOrderSize])
+	if dec == nil {
+		return nil, s, ErrInvalidStorage
+	}
+
+	_, srid := readUint32(b[2*ByteOrderSize:], dec)
+	_, code := readUint32(b[spatialitePrefixSize+ByteOrderSize:], dec)
+
+	// geometry body matches WKB once entity markers are replaced with byte order
+	raw := make([]byte, len(b)-spatialitePrefixSize)
+	copy(raw, b[spatialitePrefixSize:])
+	raw[0] = b[ByteOrderSize]
+
+	n, err := walk(raw, HeaderSize, dec, kind(code), func(off int) error {
+		if raw[off] != spatialiteEntity {
+			return ErrInvalidStorage
+		}
+		raw[off] = b[ByteOrderSize]
+		return nil
+	}, nil)
+	if err != nil {
+		return nil, s, err
+	}
+
+	if n >= len(raw) || raw[n] != spatialiteEnd {
+		return nil, s, ErrInvalidStorage
+	}
+
+	_, g, err := ReadGeometry(raw[:n])
+	if err != nil {
+		return nil, s, err
+	}
+
+	s.SRID = int(int32(srid))
+	s.Geometry = g
+	return b[spatialitePrefixSize+n+ByteOrderSize:], s, nil
+}
+
+func (s Spatialite) ByteSize() int {
+	return spatialitePrefixSize + s.Geometry.ByteSize() + ByteOrderSize
+}
+
+// Equal compares geometry with other, which must have same SRID when it is Spatialite too.
+func (s Spatialite) Equal(other Geometry) bool {
+	if o, ok := other.(Spatialite); ok {
+		if s.SRID != o.SRID {
+			return false
+		}
+		other = o.Geometry
+	}
+	return s.Geometry.Equal(other)
+}
+
+// Write encodes geometry as WKB in place and rewrites it into BLOB layout.
+func (s Spatialite) Write(buf *bytes.Buffer) {
+	buf.Write(s.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (s Spatialite) AppendWKB(dst []byte) []byte {
+	if s.Geometry == nil {
+		return dst
+	}
+	e := appendEncoder(dst)
+	s.encode(e)
+	return e.release()
+}
+
+func (s Spatialite) encode(w *encoder) {
+	start := len(w.buf)
+	w.buf = append(w.buf, make([]byte, spatialitePrefixSize)...)
+	w.geometry(s.Geometry)
+	w.buf = append(w.buf, spatialiteEnd)
+
+	b := w.buf[start:]
+	order := b[spatialitePrefixSize]
+	enc := byteOrder(order)
+
+	code := enc.Uint32(b[spatialitePrefixSize+ByteOrderSize:])
+	walk(b, spatialitePrefixSize+HeaderSize, enc, kind(code), func(off int) error {
+		b[off] = spatialiteEntity
+		return nil
+	}, nil)
+
+	mbr := s.Geometry.Envelope()
+	if mbr.IsEmpty() {
+		mbr = Envelope{}
+	}
+
+	b[0] = spatialiteStart
+	b[ByteOrderSize] = order
+	enc.PutUint32(b[2*ByteOrderSize:], uint32(int32(s.SRID)))
+	dst := b[2*ByteOrderSize+SRIDSize:]
+	for i, f := range []float64{mbr.MinX, mbr.MinY, mbr.MaxX, mbr.MaxY} {
+		enc.PutUint64(dst[i*Float64Size:], math.Float64bits(f))
+	}
+	b[spatialitePrefixSize] = spatialiteMBREnd
+}
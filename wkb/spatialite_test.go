@@ -0,0 +1,115 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawSpatialitePoint = []byte{
+		0x00, 0x01, // start, byte order
+		0xe6, 0x10, 0x00, 0x00, // srid - 4326
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40, // mbr
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x7c, 0x01, 0x00, 0x00, 0x00, // mbr end, class
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0xfe, // end
+	}
+	rawSpatialiteMultiPointZ = []byte{
+		0x00, 0x01, // start, byte order
+		0xe6, 0x10, 0x00, 0x00, // srid - 4326
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40, // mbr
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x7c, 0xec, 0x03, 0x00, 0x00, // mbr end, class
+		0x02, 0x00, 0x00, 0x00, // numpoints - 2
+		0x69, 0xe9, 0x03, 0x00, 0x00, // entity - point z
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x69, 0xe9, 0x03, 0x00, 0x00, // entity - point z
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0xfe, // end
+	}
+)
+
+func TestSpatialite(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// too short
+			ErrInvalidStorage,
+			rawSpatialitePoint[:40],
+		},
+		{
+			// plain wkb
+			ErrInvalidStorage,
+			append(rawPoint, make([]byte, 32)...),
+		},
+		{
+			// missing end marker
+			ErrInvalidStorage,
+			rawSpatialitePoint[:len(rawSpatialitePoint)-1],
+		},
+		{
+			// wkb byte order instead of entity marker
+			ErrInvalidStorage,
+			func() []byte {
+				b := append([]byte{}, rawSpatialiteMultiPointZ...)
+				b[47] = 0x01
+				return b
+			}(),
+		},
+		{
+			// unsupported class
+			ErrUnsupportedValue,
+			func() []byte {
+				b := append([]byte{}, rawSpatialitePoint...)
+				b[39] = 0x42
+				return b
+			}(),
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&Spatialite{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	if err := (&Spatialite{}).Scan(""); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+	}
+
+	s := Spatialite{}
+	if assert.NoError(t, s.Scan(rawSpatialitePoint)) {
+		assert.Equal(t, Spatialite{4326, Point{30, 10}}, s)
+	}
+
+	if raw, err := s.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawSpatialitePoint, raw)
+		assert.Len(t, raw, s.ByteSize())
+	}
+
+	s = Spatialite{}
+	if assert.NoError(t, s.Scan(rawSpatialiteMultiPointZ)) {
+		assert.Equal(t, Spatialite{4326, MultiPointZ{{10, 40, 1}, {40, 30, 2}}}, s)
+	}
+
+	if raw, err := s.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawSpatialiteMultiPointZ, raw)
+	}
+
+	_, err := Spatialite{}.Value()
+	assert.ErrorIs(t, err, ErrUnsupportedValue)
+	assert.Empty(t, Spatialite{}.AppendWKB(nil))
+}
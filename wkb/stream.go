@@ -0,0 +1,233 @@
+package wkb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"io"
+)
+
+// Encoder writes sequence of WKB geometries to output stream.
+type Encoder struct {
+	w    io.Writer
+	buf  []byte
+	opts []EncoderOption
+}
+
+func NewEncoder(w io.Writer, opts ...EncoderOption) *Encoder {
+	return &Encoder{w: w, opts: opts}
+}
+
+// Encode writes geometry, buffering only its own encoded form.
+func (enc *Encoder) Encode(g Geometry) error {
+	e := newEncoder(enc.buf[:0], enc.opts...)
+	e.geometry(g)
+	enc.buf = e.buf
+	_, err := enc.w.Write(enc.buf)
+	return err
+}
+
+// Decoder reads sequence of WKB geometries from input stream.
+type Decoder struct {
+	r    *bufio.Reader
+	buf  bytes.Buffer
+	opts []DecoderOption
+	d    *decoder
+}
+
+func NewDecoder(r io.Reader, opts ...DecoderOption) *Decoder {
+	return &Decoder{r: bufio.NewReader(r), opts: opts}
+}
+
+// Decode reads next geometry from stream.
+// It returns io.EOF when stream ends between geometries and io.ErrUnexpectedEOF when it ends inside one.
+func (dec *Decoder) Decode() (Geometry, error) {
+	b, err := dec.next()
+	if err != nil {
+		return nil, err
+	}
+
+	_, g, err := newDecoder(b, dec.opts...).geometry(b)
+	return g, err
+}
+
+// DecodeTo reads next geometry from stream into dst, reusing its storage like UnmarshalTo.
+func (dec *Decoder) DecodeTo(dst encoding.BinaryUnmarshaler) error {
+	b, err := dec.next()
+	if err != nil {
+		return err
+	}
+	return UnmarshalTo(b, dst, dec.opts...)
+}
+
+// next copies next geometry from stream into buffer.
+func (dec *Decoder) next() ([]byte, error) {
+	dec.buf.Reset()
+	dec.d = newDecoder(nil, dec.opts...)
+	if _, err := dec.r.Peek(1); err != nil {
+		return nil, err
+	}
+
+	if err := dec.geometry(0); err != nil {
+		return nil, err
+	}
+	return dec.buf.Bytes(), nil
+}
+
+// geometry copies single encoded geometry from stream, following its counts to find where it ends.
+// Member kind is enforced when non-zero.
+func (dec *Decoder) geometry(member Kind) error {
+	off := dec.buf.Len()
+	dec.d.expected, dec.d.actual = member, 0
+	if err := dec.read(int64(HeaderSize)); err != nil {
+		return err
+	}
+
+	b := dec.buf.Bytes()[off:]
+	order := byteOrder(b[0])
+	if order == nil {
+		return dec.fail(off, ErrInvalidStorage)
+	}
+
+	code := order.Uint32(b[ByteOrderSize:])
+	k := kind(code)
+	dec.d.actual = k
+	dec.d.root(k)
+
+	if code&ewkbSRID != 0 {
+		if err := dec.read(int64(SRIDSize)); err != nil {
+			return err
+		}
+	}
+
+	if member != 0 && k != member {
+		return dec.fail(off, ErrUnsupportedValue)
+	}
+
+	size, ok := coordSize(k)
+	if !ok {
+		return dec.fail(off, ErrUnsupportedValue)
+	}
+
+	switch k % 1000 {
+	case GeomPoint:
+		return dec.read(int64(size))
+	case GeomLineString, GeomCircularString:
+		n, err := dec.count(order)
+		if err != nil {
+			return err
+		}
+		return dec.points(n, size)
+	case GeomPolygon, GeomTriangle:
+		n, err := dec.count(order)
+		if err != nil {
+			return err
+		}
+		dec.d.push("ring")
+		for i := 0; i < n; i++ {
+			dec.d.at(i)
+			m, err := dec.count(order)
+			if err != nil {
+				return err
+			}
+			if err := dec.points(m, size); err != nil {
+				return err
+			}
+		}
+		dec.d.pop()
+		return nil
+	case GeomMultiPoint, GeomMultiLineString, GeomMultiPolygon, GeomPolyhedralSurface, GeomTIN:
+		n, err := dec.count(order)
+		if err != nil {
+			return err
+		}
+		dec.d.push("")
+		for i := 0; i < n; i++ {
+			dec.d.at(i)
+			if err := dec.geometry(memberKinds[k%1000] + k/1000*1000); err != nil {
+				return err
+			}
+		}
+		dec.d.pop()
+		return nil
+	case GeomCollection, GeomCompoundCurve, GeomCurvePolygon, GeomMultiCurve, GeomMultiSurface:
+		if !dec.d.enter() {
+			return dec.fail(off, ErrLimitExceeded)
+		}
+		defer dec.d.leave()
+
+		n, err := dec.count(order)
+		if err != nil {
+			return err
+		}
+		dec.d.push("")
+		for i := 0; i < n; i++ {
+			dec.d.at(i)
+			if err := dec.geometry(0); err != nil {
+				return err
+			}
+		}
+		dec.d.pop()
+		return nil
+	default:
+		return dec.fail(off, ErrUnsupportedValue)
+	}
+}
+
+var memberKinds = map[Kind]Kind{
+	GeomMultiPoint:        GeomPoint,
+	GeomMultiLineString:   GeomLineString,
+	GeomMultiPolygon:      GeomPolygon,
+	GeomPolyhedralSurface: GeomPolygon,
+	GeomTIN:               GeomTriangle,
+}
+
+func (dec *Decoder) count(order binary.ByteOrder) (int, error) {
+	off := dec.buf.Len()
+	if err := dec.read(int64(CountSize)); err != nil {
+		return 0, err
+	}
+
+	_, n := readCount(dec.buf.Bytes()[off:], order)
+	dec.d.elements += n
+	if limit := dec.d.limits.MaxElements; limit > 0 && dec.d.elements > limit {
+		return 0, dec.fail(off, ErrLimitExceeded)
+	}
+	return n, nil
+}
+
+// points reads n coordinates, reporting first one cut short by end of stream.
+func (dec *Decoder) points(n, size int) error {
+	off := dec.buf.Len()
+	err := dec.read(int64(n) * int64(size))
+	if e, ok := err.(*DecodeError); ok && e.Err == io.ErrUnexpectedEOF {
+		i := (dec.buf.Len() - off) / size
+		dec.d.push("point")
+		dec.d.at(i)
+		return dec.fail(off+i*size, io.ErrUnexpectedEOF)
+	}
+	return err
+}
+
+// fail reports err at offset from start of geometry.
+func (dec *Decoder) fail(off int, err error) error {
+	dec.d.size = off
+	return dec.d.fail(nil, err)
+}
+
+// read appends n bytes from stream, growing buffer only as data arrives.
+func (dec *Decoder) read(n int64) error {
+	if limit := dec.d.limits.MaxBytes; limit > 0 && int64(dec.buf.Len())+n > int64(limit) {
+		return dec.fail(dec.buf.Len(), ErrLimitExceeded)
+	}
+
+	m, err := dec.buf.ReadFrom(io.LimitReader(dec.r, n))
+	if err != nil {
+		return err
+	}
+	if m < n {
+		return dec.fail(dec.buf.Len(), io.ErrUnexpectedEOF)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package wkb
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	geoms := []Geometry{
+		Point{30, 10},
+		LineStringZ{{30, 10, 1}, {10, 30, 2}},
+		Polygon{{{30, 10}, {40, 40}, {20, 40}, {30, 10}}},
+		MultiPointM{{30, 10, 1}, {10, 30, 2}},
+		MultiPolygonZM{{{{30, 10, 1, 2}, {40, 40, 3, 4}, {30, 10, 1, 2}}}},
+		GeometryCollection{Point{4, 6}, LineString{{4, 6}, {7, 10}}},
+		CurvePolygonZ{CompoundCurveZ{CircularStringZ{{0, 0, 1}, {1, 1, 2}, {2, 0, 3}}, LineStringZ{{2, 0, 3}, {0, 0, 1}}}},
+		MultiSurface{Polygon{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}, CurvePolygon{CircularString{{0, 0}, {2, 0}, {0, 0}}}},
+		TINM{{{{0, 0, 1}, {1, 0, 2}, {0, 1, 3}, {0, 0, 1}}}},
+	}
+
+	for _, order := range []ByteOrder{LittleEndian, BigEndian} {
+		buf := &bytes.Buffer{}
+		enc := NewEncoder(buf, WithByteOrder(order))
+		for _, g := range geoms {
+			assert.NoError(t, enc.Encode(g))
+		}
+
+		dec := NewDecoder(iotest.OneByteReader(buf))
+		for _, expected := range geoms {
+			g, err := dec.Decode()
+			if assert.NoError(t, err) {
+				assert.Equal(t, expected, g)
+			}
+		}
+
+		_, err := dec.Decode()
+		assert.Equal(t, io.EOF, err)
+	}
+}
+
+func TestDecoderInvalid(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// header too short
+			io.ErrUnexpectedEOF,
+			[]byte{0x01, 0x01},
+		},
+		{
+			// invalid byte order
+			ErrInvalidStorage,
+			[]byte{0x02, 0x01, 0x00, 0x00, 0x00},
+		},
+		{
+			// unknown type
+			ErrUnsupportedValue,
+			[]byte{0x01, 0x63, 0x00, 0x00, 0x00},
+		},
+		{
+			// truncated payload
+			io.ErrUnexpectedEOF,
+			rawPoint[:len(rawPoint)-1],
+		},
+		{
+			// count exceeding stream
+			io.ErrUnexpectedEOF,
+			[]byte{
+				0x01, 0x02, 0x00, 0x00, 0x00,
+				0xff, 0xff, 0xff, 0xff,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x40,
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		_, err := NewDecoder(bytes.NewReader(e.b)).Decode()
+		assert.ErrorIs(t, err, e.err)
+	}
+}
+
+func TestDecodeTo(t *testing.T) {
+	buf := &bytes.Buffer{}
+	enc := NewEncoder(buf)
+	assert.NoError(t, enc.Encode(LineString{{30, 10}, {10, 30}, {40, 40}}))
+	assert.NoError(t, enc.Encode(LineString{{1, 2}, {3, 4}}))
+	assert.NoError(t, enc.Encode(Point{1, 2}))
+
+	dec := NewDecoder(buf)
+	ls := LineString{}
+	if assert.NoError(t, dec.DecodeTo(&ls)) {
+		assert.Equal(t, LineString{{30, 10}, {10, 30}, {40, 40}}, ls)
+	}
+
+	first := &ls[0]
+	if assert.NoError(t, dec.DecodeTo(&ls)) {
+		assert.Equal(t, LineString{{1, 2}, {3, 4}}, ls)
+		assert.Same(t, first, &ls[0])
+	}
+
+	if err := dec.DecodeTo(&ls); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	assert.Equal(t, io.EOF, dec.DecodeTo(&ls))
+}
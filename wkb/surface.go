@@ -0,0 +1,651 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+func (cp *CurvePolygon) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cp.UnmarshalBinary(b)
+}
+
+func (cp CurvePolygon) Value() (driver.Value, error) {
+	return cp.MarshalBinary()
+}
+
+func (cp CurvePolygon) MarshalBinary() ([]byte, error) {
+	return cp.AppendWKB(make([]byte, 0, cp.ByteSize())), nil
+}
+
+func (cp *CurvePolygon) UnmarshalBinary(b []byte) error {
+	return cp.unmarshal(newDecoder(b), b)
+}
+
+func (cp *CurvePolygon) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.curvePolygon(b)
+	if err != nil {
+		return err
+	}
+
+	*cp = tmp
+	return nil
+}
+
+func ReadCurvePolygon(b []byte) ([]byte, CurvePolygon, error) {
+	return newDecoder(b).curvePolygon(b)
+}
+
+// curvePolygon reads polygon with rings made of any curves.
+func (d *decoder) curvePolygon(b []byte) ([]byte, CurvePolygon, error) {
+	b, dec, err := d.header(b, GeomCurvePolygon)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "ring", GeomLineString, GeomCircularString, GeomCompoundCurve)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (cp CurvePolygon) IsEmpty() bool {
+	return isEmpty(cp)
+}
+
+func (cp CurvePolygon) Kind() Kind {
+	return GeomCurvePolygon
+}
+
+func (cp CurvePolygon) Dimension() int {
+	return 2
+}
+
+func (cp CurvePolygon) Envelope() Envelope {
+	return envelopeAll(cp)
+}
+
+func (cp CurvePolygon) NumPoints() int {
+	return numPointsAll(cp)
+}
+
+func (cp CurvePolygon) Equal(other Geometry) bool {
+	o, ok := other.(CurvePolygon)
+	return ok && equalAll(cp, o)
+}
+
+// Centroid returns center of mass of linearized polygon, empty point when it is empty.
+func (cp CurvePolygon) Centroid() Point {
+	c := centroid{}
+	cp.addCentroid(&c)
+	return c.result()
+}
+
+func (cp CurvePolygon) addCentroid(c *centroid) {
+	cp.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cp CurvePolygon) addShape(s *shape) {
+	cp.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cp CurvePolygon) ByteSize() int {
+	return membersSize(cp)
+}
+
+func (cp CurvePolygon) Write(buf *bytes.Buffer) {
+	buf.Write(cp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cp CurvePolygon) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cp.encode(e)
+	return e.release()
+}
+
+func (cp CurvePolygon) encode(e *encoder) {
+	e.members(GeomCurvePolygon, cp)
+}
+
+func (ms *MultiSurface) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ms.UnmarshalBinary(b)
+}
+
+func (ms MultiSurface) Value() (driver.Value, error) {
+	return ms.MarshalBinary()
+}
+
+func (ms MultiSurface) MarshalBinary() ([]byte, error) {
+	return ms.AppendWKB(make([]byte, 0, ms.ByteSize())), nil
+}
+
+func (ms *MultiSurface) UnmarshalBinary(b []byte) error {
+	return ms.unmarshal(newDecoder(b), b)
+}
+
+func (ms *MultiSurface) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiSurface(b)
+	if err != nil {
+		return err
+	}
+
+	*ms = tmp
+	return nil
+}
+
+func ReadMultiSurface(b []byte) ([]byte, MultiSurface, error) {
+	return newDecoder(b).multiSurface(b)
+}
+
+func (d *decoder) multiSurface(b []byte) ([]byte, MultiSurface, error) {
+	b, dec, err := d.header(b, GeomMultiSurface)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomPolygon, GeomCurvePolygon)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (ms MultiSurface) IsEmpty() bool {
+	return isEmpty(ms)
+}
+
+func (ms MultiSurface) Kind() Kind {
+	return GeomMultiSurface
+}
+
+func (ms MultiSurface) Dimension() int {
+	return 2
+}
+
+func (ms MultiSurface) Envelope() Envelope {
+	return envelopeAll(ms)
+}
+
+func (ms MultiSurface) NumPoints() int {
+	return numPointsAll(ms)
+}
+
+func (ms MultiSurface) Equal(other Geometry) bool {
+	o, ok := other.(MultiSurface)
+	return ok && equalAll(ms, o)
+}
+
+// Centroid returns center of mass of linearized surfaces, empty point when it is empty.
+func (ms MultiSurface) Centroid() Point {
+	c := centroid{}
+	ms.addCentroid(&c)
+	return c.result()
+}
+
+func (ms MultiSurface) addCentroid(c *centroid) {
+	ms.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (ms MultiSurface) addShape(s *shape) {
+	ms.Linearize(DefaultSegments).addShape(s)
+}
+
+func (ms MultiSurface) ByteSize() int {
+	return membersSize(ms)
+}
+
+func (ms MultiSurface) Write(buf *bytes.Buffer) {
+	buf.Write(ms.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ms MultiSurface) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ms.encode(e)
+	return e.release()
+}
+
+func (ms MultiSurface) encode(e *encoder) {
+	e.members(GeomMultiSurface, ms)
+}
+
+// Scan decodes geometry into ps, reusing its backing arrays as UnmarshalTo does.
+func (ps *PolyhedralSurface) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ps.UnmarshalBinary(b)
+}
+
+func (ps PolyhedralSurface) Value() (driver.Value, error) {
+	return ps.MarshalBinary()
+}
+
+func (ps PolyhedralSurface) MarshalBinary() ([]byte, error) {
+	return ps.AppendWKB(make([]byte, 0, ps.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into ps, reusing its backing arrays as UnmarshalTo does.
+func (ps *PolyhedralSurface) UnmarshalBinary(b []byte) error {
+	return ps.unmarshal(newDecoder(b), b)
+}
+
+func (ps *PolyhedralSurface) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.polyhedralSurface(b, reuse(d, b, *ps))
+	if err != nil {
+		return err
+	}
+
+	*ps = tmp
+	return nil
+}
+
+func ReadPolyhedralSurface(b []byte) ([]byte, PolyhedralSurface, error) {
+	return newDecoder(b).polyhedralSurface(b, nil)
+}
+
+func (d *decoder) polyhedralSurface(b []byte, dst PolyhedralSurface) ([]byte, PolyhedralSurface, error) {
+	b, dec, err := d.header(b, GeomPolyhedralSurface)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, ps[i], err = d.polygon(b, ps[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, ps, nil
+}
+
+func (ps PolyhedralSurface) IsEmpty() bool {
+	return MultiPolygon(ps).IsEmpty()
+}
+
+func (ps PolyhedralSurface) Kind() Kind {
+	return GeomPolyhedralSurface
+}
+
+func (ps PolyhedralSurface) Dimension() int {
+	return 2
+}
+
+func (ps PolyhedralSurface) Envelope() Envelope {
+	return MultiPolygon(ps).Envelope()
+}
+
+func (ps PolyhedralSurface) NumPoints() int {
+	return MultiPolygon(ps).NumPoints()
+}
+
+func (ps PolyhedralSurface) Equal(other Geometry) bool {
+	o, ok := other.(PolyhedralSurface)
+	return ok && MultiPolygon(ps).Equal(MultiPolygon(o))
+}
+
+// Centroid returns center of mass of polygons projected to XY plane, empty point when it is empty.
+func (ps PolyhedralSurface) Centroid() Point {
+	c := centroid{}
+	ps.addCentroid(&c)
+	return c.result()
+}
+
+func (ps PolyhedralSurface) addCentroid(c *centroid) {
+	MultiPolygon(ps).addCentroid(c)
+}
+
+func (ps PolyhedralSurface) addShape(s *shape) {
+	MultiPolygon(ps).addShape(s)
+}
+
+func (ps PolyhedralSurface) ByteSize() int {
+	return MultiPolygon(ps).ByteSize()
+}
+
+func (ps PolyhedralSurface) Write(buf *bytes.Buffer) {
+	buf.Write(ps.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ps PolyhedralSurface) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ps.encode(e)
+	return e.release()
+}
+
+func (ps PolyhedralSurface) encode(e *encoder) {
+	e.header(GeomPolyhedralSurface)
+	e.count(len(ps))
+	for _, p := range ps {
+		p.encode(e)
+	}
+}
+
+// Scan decodes geometry into tin, reusing its backing arrays as UnmarshalTo does.
+func (tin *TIN) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return tin.UnmarshalBinary(b)
+}
+
+func (tin TIN) Value() (driver.Value, error) {
+	return tin.MarshalBinary()
+}
+
+func (tin TIN) MarshalBinary() ([]byte, error) {
+	return tin.AppendWKB(make([]byte, 0, tin.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into tin, reusing its backing arrays as UnmarshalTo does.
+func (tin *TIN) UnmarshalBinary(b []byte) error {
+	return tin.unmarshal(newDecoder(b), b)
+}
+
+func (tin *TIN) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.tin(b, reuse(d, b, *tin))
+	if err != nil {
+		return err
+	}
+
+	*tin = tmp
+	return nil
+}
+
+func ReadTIN(b []byte) ([]byte, TIN, error) {
+	return newDecoder(b).tin(b, nil)
+}
+
+func (d *decoder) tin(b []byte, dst TIN) ([]byte, TIN, error) {
+	b, dec, err := d.header(b, GeomTIN)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tin := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, tin[i], err = d.triangle(b, tin[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, tin, nil
+}
+
+func (tin TIN) IsEmpty() bool {
+	for _, t := range tin {
+		if !t.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (tin TIN) Kind() Kind {
+	return GeomTIN
+}
+
+func (tin TIN) Dimension() int {
+	return 2
+}
+
+func (tin TIN) Envelope() Envelope {
+	return envelopeAll(tin)
+}
+
+func (tin TIN) NumPoints() int {
+	return numPointsAll(tin)
+}
+
+func (tin TIN) Equal(other Geometry) bool {
+	o, ok := other.(TIN)
+	return ok && equalAll(tin, o)
+}
+
+// Centroid returns center of mass of triangles, empty point when it is empty.
+func (tin TIN) Centroid() Point {
+	c := centroid{}
+	tin.addCentroid(&c)
+	return c.result()
+}
+
+func (tin TIN) addCentroid(c *centroid) {
+	for _, t := range tin {
+		t.addCentroid(c)
+	}
+}
+
+func (tin TIN) addShape(s *shape) {
+	for _, t := range tin {
+		t.addShape(s)
+	}
+}
+
+func (tin TIN) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, t := range tin {
+		size += t.ByteSize()
+	}
+	return size
+}
+
+func (tin TIN) Write(buf *bytes.Buffer) {
+	buf.Write(tin.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (tin TIN) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	tin.encode(e)
+	return e.release()
+}
+
+func (tin TIN) encode(e *encoder) {
+	e.header(GeomTIN)
+	e.count(len(tin))
+	for _, t := range tin {
+		t.encode(e)
+	}
+}
+
+// Scan decodes geometry into t, reusing its backing arrays as UnmarshalTo does.
+func (t *Triangle) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalBinary(b)
+}
+
+func (t Triangle) Value() (driver.Value, error) {
+	return t.MarshalBinary()
+}
+
+func (t Triangle) MarshalBinary() ([]byte, error) {
+	return t.AppendWKB(make([]byte, 0, t.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into t, reusing its backing arrays as UnmarshalTo does.
+func (t *Triangle) UnmarshalBinary(b []byte) error {
+	return t.unmarshal(newDecoder(b), b)
+}
+
+func (t *Triangle) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.triangle(b, reuse(d, b, *t))
+	if err != nil {
+		return err
+	}
+
+	*t = tmp
+	return nil
+}
+
+func ReadTriangle(b []byte) ([]byte, Triangle, error) {
+	return newDecoder(b).triangle(b, nil)
+}
+
+// validTriangle reports whether ring of triangle has four points, last one closing it.
+func validTriangle[R ~[]T, T comparable](r R) bool {
+	return len(r) == 4 && r[0] == r[3]
+}
+
+func (d *decoder) triangle(b []byte, dst Triangle) ([]byte, Triangle, error) {
+	b, dec, err := d.header(b, GeomTriangle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, n, err := d.count(b, dec, CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n > 1 {
+		return nil, nil, d.fail(b, ErrInvalidStorage)
+	}
+	b = rest
+
+	t := grow(dst, n)
+	d.push("ring")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		rest, t[i], err = d.linearRing(b, dec, t[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if !validTriangle(t[i]) {
+			return nil, nil, d.fail(b, ErrInvalidStorage)
+		}
+		b = rest
+	}
+	d.pop()
+
+	return b, t, nil
+}
+
+func (t Triangle) IsEmpty() bool {
+	return Polygon(t).IsEmpty()
+}
+
+func (t Triangle) Kind() Kind {
+	return GeomTriangle
+}
+
+func (t Triangle) Dimension() int {
+	return 2
+}
+
+func (t Triangle) Envelope() Envelope {
+	return Polygon(t).Envelope()
+}
+
+func (t Triangle) NumPoints() int {
+	return Polygon(t).NumPoints()
+}
+
+func (t Triangle) Equal(other Geometry) bool {
+	o, ok := other.(Triangle)
+	return ok && Polygon(t).Equal(Polygon(o))
+}
+
+func (t Triangle) Area() float64 {
+	return Polygon(t).Area()
+}
+
+func (t Triangle) Perimeter() float64 {
+	return Polygon(t).Perimeter()
+}
+
+// Centroid returns center of mass of triangle, empty point when it is empty.
+func (t Triangle) Centroid() Point {
+	c := centroid{}
+	t.addCentroid(&c)
+	return c.result()
+}
+
+func (t Triangle) addCentroid(c *centroid) {
+	Polygon(t).addCentroid(c)
+}
+
+func (t Triangle) addShape(s *shape) {
+	Polygon(t).addShape(s)
+}
+
+func (t Triangle) ByteSize() int {
+	return Polygon(t).ByteSize()
+}
+
+func (t Triangle) Write(buf *bytes.Buffer) {
+	buf.Write(t.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (t Triangle) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	t.encode(e)
+	return e.release()
+}
+
+func (t Triangle) encode(e *encoder) {
+	e.header(GeomTriangle)
+	e.count(len(t))
+	for _, lr := range t {
+		lr.encode(e)
+	}
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (cp CurvePolygon) Linearize(segments int) Polygon {
+	poly := make(Polygon, len(cp))
+	for i, g := range cp {
+		poly[i] = LinearRing(linearizeCurve(g, segments))
+	}
+	return poly
+}
+
+func (cp CurvePolygon) linearize(segments int) Geometry {
+	return cp.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (ms MultiSurface) Linearize(segments int) MultiPolygon {
+	mp := make(MultiPolygon, len(ms))
+	for i, g := range ms {
+		switch s := g.(type) {
+		case Polygon:
+			mp[i] = s
+		case CurvePolygon:
+			mp[i] = s.Linearize(segments)
+		}
+	}
+	return mp
+}
+
+func (ms MultiSurface) linearize(segments int) Geometry {
+	return ms.Linearize(segments)
+}
@@ -0,0 +1,646 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+func (cp *CurvePolygonM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cp.UnmarshalBinary(b)
+}
+
+func (cp CurvePolygonM) Value() (driver.Value, error) {
+	return cp.MarshalBinary()
+}
+
+func (cp CurvePolygonM) MarshalBinary() ([]byte, error) {
+	return cp.AppendWKB(make([]byte, 0, cp.ByteSize())), nil
+}
+
+func (cp *CurvePolygonM) UnmarshalBinary(b []byte) error {
+	return cp.unmarshal(newDecoder(b), b)
+}
+
+func (cp *CurvePolygonM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.curvePolygonM(b)
+	if err != nil {
+		return err
+	}
+
+	*cp = tmp
+	return nil
+}
+
+func ReadCurvePolygonM(b []byte) ([]byte, CurvePolygonM, error) {
+	return newDecoder(b).curvePolygonM(b)
+}
+
+// curvePolygonM reads polygon with rings made of any curves.
+func (d *decoder) curvePolygonM(b []byte) ([]byte, CurvePolygonM, error) {
+	b, dec, err := d.header(b, GeomCurvePolygonM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "ring", GeomLineStringM, GeomCircularStringM, GeomCompoundCurveM)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (cp CurvePolygonM) IsEmpty() bool {
+	return isEmpty(cp)
+}
+
+func (cp CurvePolygonM) Kind() Kind {
+	return GeomCurvePolygonM
+}
+
+func (cp CurvePolygonM) Dimension() int {
+	return 2
+}
+
+func (cp CurvePolygonM) Envelope() Envelope {
+	return envelopeAll(cp)
+}
+
+func (cp CurvePolygonM) NumPoints() int {
+	return numPointsAll(cp)
+}
+
+func (cp CurvePolygonM) Equal(other Geometry) bool {
+	o, ok := other.(CurvePolygonM)
+	return ok && equalAll(cp, o)
+}
+
+// Centroid returns center of mass of linearized polygon, empty point when it is empty.
+func (cp CurvePolygonM) Centroid() Point {
+	c := centroid{}
+	cp.addCentroid(&c)
+	return c.result()
+}
+
+func (cp CurvePolygonM) addCentroid(c *centroid) {
+	cp.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cp CurvePolygonM) addShape(s *shape) {
+	cp.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cp CurvePolygonM) ByteSize() int {
+	return membersSize(cp)
+}
+
+func (cp CurvePolygonM) Write(buf *bytes.Buffer) {
+	buf.Write(cp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cp CurvePolygonM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cp.encode(e)
+	return e.release()
+}
+
+func (cp CurvePolygonM) encode(e *encoder) {
+	e.members(GeomCurvePolygonM, cp)
+}
+
+func (ms *MultiSurfaceM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ms.UnmarshalBinary(b)
+}
+
+func (ms MultiSurfaceM) Value() (driver.Value, error) {
+	return ms.MarshalBinary()
+}
+
+func (ms MultiSurfaceM) MarshalBinary() ([]byte, error) {
+	return ms.AppendWKB(make([]byte, 0, ms.ByteSize())), nil
+}
+
+func (ms *MultiSurfaceM) UnmarshalBinary(b []byte) error {
+	return ms.unmarshal(newDecoder(b), b)
+}
+
+func (ms *MultiSurfaceM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiSurfaceM(b)
+	if err != nil {
+		return err
+	}
+
+	*ms = tmp
+	return nil
+}
+
+func ReadMultiSurfaceM(b []byte) ([]byte, MultiSurfaceM, error) {
+	return newDecoder(b).multiSurfaceM(b)
+}
+
+func (d *decoder) multiSurfaceM(b []byte) ([]byte, MultiSurfaceM, error) {
+	b, dec, err := d.header(b, GeomMultiSurfaceM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomPolygonM, GeomCurvePolygonM)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (ms MultiSurfaceM) IsEmpty() bool {
+	return isEmpty(ms)
+}
+
+func (ms MultiSurfaceM) Kind() Kind {
+	return GeomMultiSurfaceM
+}
+
+func (ms MultiSurfaceM) Dimension() int {
+	return 2
+}
+
+func (ms MultiSurfaceM) Envelope() Envelope {
+	return envelopeAll(ms)
+}
+
+func (ms MultiSurfaceM) NumPoints() int {
+	return numPointsAll(ms)
+}
+
+func (ms MultiSurfaceM) Equal(other Geometry) bool {
+	o, ok := other.(MultiSurfaceM)
+	return ok && equalAll(ms, o)
+}
+
+// Centroid returns center of mass of linearized surfaces, empty point when it is empty.
+func (ms MultiSurfaceM) Centroid() Point {
+	c := centroid{}
+	ms.addCentroid(&c)
+	return c.result()
+}
+
+func (ms MultiSurfaceM) addCentroid(c *centroid) {
+	ms.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (ms MultiSurfaceM) addShape(s *shape) {
+	ms.Linearize(DefaultSegments).addShape(s)
+}
+
+func (ms MultiSurfaceM) ByteSize() int {
+	return membersSize(ms)
+}
+
+func (ms MultiSurfaceM) Write(buf *bytes.Buffer) {
+	buf.Write(ms.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ms MultiSurfaceM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ms.encode(e)
+	return e.release()
+}
+
+func (ms MultiSurfaceM) encode(e *encoder) {
+	e.members(GeomMultiSurfaceM, ms)
+}
+
+// Scan decodes geometry into ps, reusing its backing arrays as UnmarshalTo does.
+func (ps *PolyhedralSurfaceM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ps.UnmarshalBinary(b)
+}
+
+func (ps PolyhedralSurfaceM) Value() (driver.Value, error) {
+	return ps.MarshalBinary()
+}
+
+func (ps PolyhedralSurfaceM) MarshalBinary() ([]byte, error) {
+	return ps.AppendWKB(make([]byte, 0, ps.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into ps, reusing its backing arrays as UnmarshalTo does.
+func (ps *PolyhedralSurfaceM) UnmarshalBinary(b []byte) error {
+	return ps.unmarshal(newDecoder(b), b)
+}
+
+func (ps *PolyhedralSurfaceM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.polyhedralSurfaceM(b, reuse(d, b, *ps))
+	if err != nil {
+		return err
+	}
+
+	*ps = tmp
+	return nil
+}
+
+func ReadPolyhedralSurfaceM(b []byte) ([]byte, PolyhedralSurfaceM, error) {
+	return newDecoder(b).polyhedralSurfaceM(b, nil)
+}
+
+func (d *decoder) polyhedralSurfaceM(b []byte, dst PolyhedralSurfaceM) ([]byte, PolyhedralSurfaceM, error) {
+	b, dec, err := d.header(b, GeomPolyhedralSurfaceM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, ps[i], err = d.polygonM(b, ps[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, ps, nil
+}
+
+func (ps PolyhedralSurfaceM) IsEmpty() bool {
+	return MultiPolygonM(ps).IsEmpty()
+}
+
+func (ps PolyhedralSurfaceM) Kind() Kind {
+	return GeomPolyhedralSurfaceM
+}
+
+func (ps PolyhedralSurfaceM) Dimension() int {
+	return 2
+}
+
+func (ps PolyhedralSurfaceM) Envelope() Envelope {
+	return MultiPolygonM(ps).Envelope()
+}
+
+func (ps PolyhedralSurfaceM) NumPoints() int {
+	return MultiPolygonM(ps).NumPoints()
+}
+
+func (ps PolyhedralSurfaceM) Equal(other Geometry) bool {
+	o, ok := other.(PolyhedralSurfaceM)
+	return ok && MultiPolygonM(ps).Equal(MultiPolygonM(o))
+}
+
+// Centroid returns center of mass of polygons projected to XY plane, empty point when it is empty.
+func (ps PolyhedralSurfaceM) Centroid() Point {
+	c := centroid{}
+	ps.addCentroid(&c)
+	return c.result()
+}
+
+func (ps PolyhedralSurfaceM) addCentroid(c *centroid) {
+	MultiPolygonM(ps).addCentroid(c)
+}
+
+func (ps PolyhedralSurfaceM) addShape(s *shape) {
+	MultiPolygonM(ps).addShape(s)
+}
+
+func (ps PolyhedralSurfaceM) ByteSize() int {
+	return MultiPolygonM(ps).ByteSize()
+}
+
+func (ps PolyhedralSurfaceM) Write(buf *bytes.Buffer) {
+	buf.Write(ps.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ps PolyhedralSurfaceM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ps.encode(e)
+	return e.release()
+}
+
+func (ps PolyhedralSurfaceM) encode(e *encoder) {
+	e.header(GeomPolyhedralSurfaceM)
+	e.count(len(ps))
+	for _, p := range ps {
+		p.encode(e)
+	}
+}
+
+// Scan decodes geometry into tin, reusing its backing arrays as UnmarshalTo does.
+func (tin *TINM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return tin.UnmarshalBinary(b)
+}
+
+func (tin TINM) Value() (driver.Value, error) {
+	return tin.MarshalBinary()
+}
+
+func (tin TINM) MarshalBinary() ([]byte, error) {
+	return tin.AppendWKB(make([]byte, 0, tin.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into tin, reusing its backing arrays as UnmarshalTo does.
+func (tin *TINM) UnmarshalBinary(b []byte) error {
+	return tin.unmarshal(newDecoder(b), b)
+}
+
+func (tin *TINM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.tinM(b, reuse(d, b, *tin))
+	if err != nil {
+		return err
+	}
+
+	*tin = tmp
+	return nil
+}
+
+func ReadTINM(b []byte) ([]byte, TINM, error) {
+	return newDecoder(b).tinM(b, nil)
+}
+
+func (d *decoder) tinM(b []byte, dst TINM) ([]byte, TINM, error) {
+	b, dec, err := d.header(b, GeomTINM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tin := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, tin[i], err = d.triangleM(b, tin[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, tin, nil
+}
+
+func (tin TINM) IsEmpty() bool {
+	for _, t := range tin {
+		if !t.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (tin TINM) Kind() Kind {
+	return GeomTINM
+}
+
+func (tin TINM) Dimension() int {
+	return 2
+}
+
+func (tin TINM) Envelope() Envelope {
+	return envelopeAll(tin)
+}
+
+func (tin TINM) NumPoints() int {
+	return numPointsAll(tin)
+}
+
+func (tin TINM) Equal(other Geometry) bool {
+	o, ok := other.(TINM)
+	return ok && equalAll(tin, o)
+}
+
+// Centroid returns center of mass of triangles, empty point when it is empty.
+func (tin TINM) Centroid() Point {
+	c := centroid{}
+	tin.addCentroid(&c)
+	return c.result()
+}
+
+func (tin TINM) addCentroid(c *centroid) {
+	for _, t := range tin {
+		t.addCentroid(c)
+	}
+}
+
+func (tin TINM) addShape(s *shape) {
+	for _, t := range tin {
+		t.addShape(s)
+	}
+}
+
+func (tin TINM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, t := range tin {
+		size += t.ByteSize()
+	}
+	return size
+}
+
+func (tin TINM) Write(buf *bytes.Buffer) {
+	buf.Write(tin.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (tin TINM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	tin.encode(e)
+	return e.release()
+}
+
+func (tin TINM) encode(e *encoder) {
+	e.header(GeomTINM)
+	e.count(len(tin))
+	for _, t := range tin {
+		t.encode(e)
+	}
+}
+
+// Scan decodes geometry into t, reusing its backing arrays as UnmarshalTo does.
+func (t *TriangleM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalBinary(b)
+}
+
+func (t TriangleM) Value() (driver.Value, error) {
+	return t.MarshalBinary()
+}
+
+func (t TriangleM) MarshalBinary() ([]byte, error) {
+	return t.AppendWKB(make([]byte, 0, t.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into t, reusing its backing arrays as UnmarshalTo does.
+func (t *TriangleM) UnmarshalBinary(b []byte) error {
+	return t.unmarshal(newDecoder(b), b)
+}
+
+func (t *TriangleM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.triangleM(b, reuse(d, b, *t))
+	if err != nil {
+		return err
+	}
+
+	*t = tmp
+	return nil
+}
+
+func ReadTriangleM(b []byte) ([]byte, TriangleM, error) {
+	return newDecoder(b).triangleM(b, nil)
+}
+
+func (d *decoder) triangleM(b []byte, dst TriangleM) ([]byte, TriangleM, error) {
+	b, dec, err := d.header(b, GeomTriangleM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, n, err := d.count(b, dec, CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n > 1 {
+		return nil, nil, d.fail(b, ErrInvalidStorage)
+	}
+	b = rest
+
+	t := grow(dst, n)
+	d.push("ring")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		rest, t[i], err = d.linearRingM(b, dec, t[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if !validTriangle(t[i]) {
+			return nil, nil, d.fail(b, ErrInvalidStorage)
+		}
+		b = rest
+	}
+	d.pop()
+
+	return b, t, nil
+}
+
+func (t TriangleM) IsEmpty() bool {
+	return PolygonM(t).IsEmpty()
+}
+
+func (t TriangleM) Kind() Kind {
+	return GeomTriangleM
+}
+
+func (t TriangleM) Dimension() int {
+	return 2
+}
+
+func (t TriangleM) Envelope() Envelope {
+	return PolygonM(t).Envelope()
+}
+
+func (t TriangleM) NumPoints() int {
+	return PolygonM(t).NumPoints()
+}
+
+func (t TriangleM) Equal(other Geometry) bool {
+	o, ok := other.(TriangleM)
+	return ok && PolygonM(t).Equal(PolygonM(o))
+}
+
+func (t TriangleM) Area() float64 {
+	return PolygonM(t).Area()
+}
+
+func (t TriangleM) Perimeter() float64 {
+	return PolygonM(t).Perimeter()
+}
+
+// Centroid returns center of mass of triangle, empty point when it is empty.
+func (t TriangleM) Centroid() Point {
+	c := centroid{}
+	t.addCentroid(&c)
+	return c.result()
+}
+
+func (t TriangleM) addCentroid(c *centroid) {
+	PolygonM(t).addCentroid(c)
+}
+
+func (t TriangleM) addShape(s *shape) {
+	PolygonM(t).addShape(s)
+}
+
+func (t TriangleM) ByteSize() int {
+	return PolygonM(t).ByteSize()
+}
+
+func (t TriangleM) Write(buf *bytes.Buffer) {
+	buf.Write(t.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (t TriangleM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	t.encode(e)
+	return e.release()
+}
+
+func (t TriangleM) encode(e *encoder) {
+	e.header(GeomTriangleM)
+	e.count(len(t))
+	for _, lr := range t {
+		lr.encode(e)
+	}
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (cp CurvePolygonM) Linearize(segments int) PolygonM {
+	poly := make(PolygonM, len(cp))
+	for i, g := range cp {
+		poly[i] = LinearRingM(linearizeCurveM(g, segments))
+	}
+	return poly
+}
+
+func (cp CurvePolygonM) linearize(segments int) Geometry {
+	return cp.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (ms MultiSurfaceM) Linearize(segments int) MultiPolygonM {
+	mp := make(MultiPolygonM, len(ms))
+	for i, g := range ms {
+		switch s := g.(type) {
+		case PolygonM:
+			mp[i] = s
+		case CurvePolygonM:
+			mp[i] = s.Linearize(segments)
+		}
+	}
+	return mp
+}
+
+func (ms MultiSurfaceM) linearize(segments int) Geometry {
+	return ms.Linearize(segments)
+}
@@ -0,0 +1,207 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawCurvePolygonM = []byte{
+		0x01, 0xda, 0x07, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x01, 0xd8, 0x07, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawMultiSurfaceM = []byte{
+		0x01, 0xdc, 0x07, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numsurfaces - 2
+		0x01, 0xd3, 0x07, 0x00, 0x00, // polygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x01, 0xda, 0x07, 0x00, 0x00, // curvepolygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x01, 0xd8, 0x07, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawPolyhedralSurfaceM = []byte{
+		0x01, 0xdf, 0x07, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numpolygons - 1
+		0x01, 0xd3, 0x07, 0x00, 0x00, // polygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawTINM = []byte{
+		0x01, 0xe0, 0x07, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numtriangles - 1
+		0x01, 0xe1, 0x07, 0x00, 0x00, // triangle
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawTriangleM = []byte{
+		0x01, 0xe1, 0x07, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+)
+
+func TestCurvePolygonM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawMultiSurfaceM,
+		},
+		{
+			// invalid ring type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0xda, 0x07, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numrings - 1
+				0x01, 0xd3, 0x07, 0x00, 0x00, // polygon
+				0x00, 0x00, 0x00, 0x00, // numrings - 0
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CurvePolygonM{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cp := CurvePolygonM{}
+	if err := cp.Scan(rawCurvePolygonM); assert.NoError(t, err) {
+		assert.Equal(t, CurvePolygonM{CircularStringM{{0, 0, 5}, {2, 0, 5}, {0, 0, 5}}}, cp)
+	}
+
+	if raw, err := cp.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCurvePolygonM, raw)
+		assert.Len(t, raw, cp.ByteSize())
+	}
+}
+
+func TestMultiSurfaceM(t *testing.T) {
+	ms := MultiSurfaceM{}
+	if err := ms.Scan(rawMultiSurfaceM); assert.NoError(t, err) {
+		assert.Equal(t, MultiSurfaceM{
+			PolygonM{{{0, 0, 5}, {1, 0, 5}, {0, 1, 5}, {0, 0, 5}}},
+			CurvePolygonM{CircularStringM{{0, 0, 5}, {2, 0, 5}, {0, 0, 5}}},
+		}, ms)
+	}
+
+	if raw, err := ms.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiSurfaceM, raw)
+		assert.Len(t, raw, ms.ByteSize())
+	}
+}
+
+func TestPolyhedralSurfaceM(t *testing.T) {
+	ps := PolyhedralSurfaceM{}
+	if err := ps.Scan(rawPolyhedralSurfaceM); assert.NoError(t, err) {
+		assert.Equal(t, PolyhedralSurfaceM{{{{0, 0, 5}, {1, 0, 5}, {0, 1, 5}, {0, 0, 5}}}}, ps)
+	}
+
+	if raw, err := ps.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawPolyhedralSurfaceM, raw)
+		assert.Len(t, raw, ps.ByteSize())
+	}
+}
+
+func TestTINM(t *testing.T) {
+	// polyhedral surface members are not triangles
+	if err := (&TINM{}).Scan(rawPolyhedralSurfaceM); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	tin := TINM{}
+	if err := tin.Scan(rawTINM); assert.NoError(t, err) {
+		assert.Equal(t, TINM{{{{0, 0, 5}, {1, 0, 5}, {0, 1, 5}, {0, 0, 5}}}}, tin)
+	}
+
+	if raw, err := tin.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawTINM, raw)
+		assert.Len(t, raw, tin.ByteSize())
+	}
+}
+
+func TestTriangleM(t *testing.T) {
+	tr := TriangleM{}
+	if err := tr.Scan(rawTriangleM); assert.NoError(t, err) {
+		assert.Equal(t, TriangleM{{{0, 0, 5}, {1, 0, 5}, {0, 1, 5}, {0, 0, 5}}}, tr)
+	}
+
+	if raw, err := tr.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawTriangleM, raw)
+		assert.Len(t, raw, tr.ByteSize())
+	}
+}
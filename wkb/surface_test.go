@@ -0,0 +1,210 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawCurvePolygon = []byte{
+		0x01, 0x0a, 0x00, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x01, 0x08, 0x00, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	rawMultiSurface = []byte{
+		0x01, 0x0c, 0x00, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numsurfaces - 2
+		0x01, 0x03, 0x00, 0x00, 0x00, // polygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x01, 0x0a, 0x00, 0x00, 0x00, // curvepolygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x01, 0x08, 0x00, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	rawPolyhedralSurface = []byte{
+		0x01, 0x0f, 0x00, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numpolygons - 1
+		0x01, 0x03, 0x00, 0x00, 0x00, // polygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	rawTIN = []byte{
+		0x01, 0x10, 0x00, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numtriangles - 1
+		0x01, 0x11, 0x00, 0x00, 0x00, // triangle
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	rawTriangle = []byte{
+		0x01, 0x11, 0x00, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+)
+
+func TestCurvePolygon(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawMultiSurface,
+		},
+		{
+			// invalid ring type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0x0a, 0x00, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numrings - 1
+				0x01, 0x03, 0x00, 0x00, 0x00, // polygon
+				0x00, 0x00, 0x00, 0x00, // numrings - 0
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CurvePolygon{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cp := CurvePolygon{}
+	if err := cp.Scan(rawCurvePolygon); assert.NoError(t, err) {
+		assert.Equal(t, CurvePolygon{CircularString{{0, 0}, {2, 0}, {0, 0}}}, cp)
+	}
+
+	if raw, err := cp.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCurvePolygon, raw)
+		assert.Len(t, raw, cp.ByteSize())
+	}
+}
+
+func TestMultiSurface(t *testing.T) {
+	ms := MultiSurface{}
+	if err := ms.Scan(rawMultiSurface); assert.NoError(t, err) {
+		assert.Equal(t, MultiSurface{
+			Polygon{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}},
+			CurvePolygon{CircularString{{0, 0}, {2, 0}, {0, 0}}},
+		}, ms)
+	}
+
+	if raw, err := ms.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiSurface, raw)
+		assert.Len(t, raw, ms.ByteSize())
+	}
+}
+
+func TestPolyhedralSurface(t *testing.T) {
+	ps := PolyhedralSurface{}
+	if err := ps.Scan(rawPolyhedralSurface); assert.NoError(t, err) {
+		assert.Equal(t, PolyhedralSurface{{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}}, ps)
+	}
+
+	if raw, err := ps.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawPolyhedralSurface, raw)
+		assert.Len(t, raw, ps.ByteSize())
+	}
+}
+
+func TestTIN(t *testing.T) {
+	// polyhedral surface members are not triangles
+	if err := (&TIN{}).Scan(rawPolyhedralSurface); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	tin := TIN{}
+	if err := tin.Scan(rawTIN); assert.NoError(t, err) {
+		assert.Equal(t, TIN{{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}}, tin)
+	}
+
+	if raw, err := tin.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawTIN, raw)
+		assert.Len(t, raw, tin.ByteSize())
+	}
+}
+
+func TestTriangle(t *testing.T) {
+	tr := Triangle{}
+	if err := tr.Scan(rawTriangle); assert.NoError(t, err) {
+		assert.Equal(t, Triangle{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}, tr)
+	}
+
+	if raw, err := tr.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawTriangle, raw)
+		assert.Len(t, raw, tr.ByteSize())
+	}
+
+	// triangle has single closed ring of four points
+	invalid := []struct {
+		offset int
+		tr     Triangle
+	}{
+		{HeaderSize, Triangle{{{0, 0}, {1, 0}, {0, 1}, {0, 0}}, {{0, 0}, {1, 0}, {0, 1}, {0, 0}}}},
+		{HeaderSize + CountSize, Triangle{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}},
+		{HeaderSize + CountSize, Triangle{{{0, 0}, {1, 0}, {0, 1}, {1, 1}}}},
+		{HeaderSize + CountSize, Triangle{{}}},
+	}
+
+	for _, e := range invalid {
+		_, _, err := ReadTriangle(Marshal(e.tr))
+		var derr *DecodeError
+		if assert.ErrorAs(t, err, &derr) {
+			assert.ErrorIs(t, err, ErrInvalidStorage)
+			assert.Equal(t, e.offset, derr.Offset)
+		}
+		_, err = New(Marshal(TIN{e.tr}))
+		assert.ErrorIs(t, err, ErrInvalidStorage)
+	}
+	if _, empty, err := ReadTriangle(Marshal(Triangle{})); assert.NoError(t, err) {
+		assert.True(t, empty.IsEmpty())
+	}
+}
@@ -0,0 +1,646 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+func (cp *CurvePolygonZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cp.UnmarshalBinary(b)
+}
+
+func (cp CurvePolygonZ) Value() (driver.Value, error) {
+	return cp.MarshalBinary()
+}
+
+func (cp CurvePolygonZ) MarshalBinary() ([]byte, error) {
+	return cp.AppendWKB(make([]byte, 0, cp.ByteSize())), nil
+}
+
+func (cp *CurvePolygonZ) UnmarshalBinary(b []byte) error {
+	return cp.unmarshal(newDecoder(b), b)
+}
+
+func (cp *CurvePolygonZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.curvePolygonZ(b)
+	if err != nil {
+		return err
+	}
+
+	*cp = tmp
+	return nil
+}
+
+func ReadCurvePolygonZ(b []byte) ([]byte, CurvePolygonZ, error) {
+	return newDecoder(b).curvePolygonZ(b)
+}
+
+// curvePolygonZ reads polygon with rings made of any curves.
+func (d *decoder) curvePolygonZ(b []byte) ([]byte, CurvePolygonZ, error) {
+	b, dec, err := d.header(b, GeomCurvePolygonZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "ring", GeomLineStringZ, GeomCircularStringZ, GeomCompoundCurveZ)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (cp CurvePolygonZ) IsEmpty() bool {
+	return isEmpty(cp)
+}
+
+func (cp CurvePolygonZ) Kind() Kind {
+	return GeomCurvePolygonZ
+}
+
+func (cp CurvePolygonZ) Dimension() int {
+	return 2
+}
+
+func (cp CurvePolygonZ) Envelope() Envelope {
+	return envelopeAll(cp)
+}
+
+func (cp CurvePolygonZ) NumPoints() int {
+	return numPointsAll(cp)
+}
+
+func (cp CurvePolygonZ) Equal(other Geometry) bool {
+	o, ok := other.(CurvePolygonZ)
+	return ok && equalAll(cp, o)
+}
+
+// Centroid returns center of mass of linearized polygon, empty point when it is empty.
+func (cp CurvePolygonZ) Centroid() Point {
+	c := centroid{}
+	cp.addCentroid(&c)
+	return c.result()
+}
+
+func (cp CurvePolygonZ) addCentroid(c *centroid) {
+	cp.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cp CurvePolygonZ) addShape(s *shape) {
+	cp.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cp CurvePolygonZ) ByteSize() int {
+	return membersSize(cp)
+}
+
+func (cp CurvePolygonZ) Write(buf *bytes.Buffer) {
+	buf.Write(cp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cp CurvePolygonZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cp.encode(e)
+	return e.release()
+}
+
+func (cp CurvePolygonZ) encode(e *encoder) {
+	e.members(GeomCurvePolygonZ, cp)
+}
+
+func (ms *MultiSurfaceZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ms.UnmarshalBinary(b)
+}
+
+func (ms MultiSurfaceZ) Value() (driver.Value, error) {
+	return ms.MarshalBinary()
+}
+
+func (ms MultiSurfaceZ) MarshalBinary() ([]byte, error) {
+	return ms.AppendWKB(make([]byte, 0, ms.ByteSize())), nil
+}
+
+func (ms *MultiSurfaceZ) UnmarshalBinary(b []byte) error {
+	return ms.unmarshal(newDecoder(b), b)
+}
+
+func (ms *MultiSurfaceZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiSurfaceZ(b)
+	if err != nil {
+		return err
+	}
+
+	*ms = tmp
+	return nil
+}
+
+func ReadMultiSurfaceZ(b []byte) ([]byte, MultiSurfaceZ, error) {
+	return newDecoder(b).multiSurfaceZ(b)
+}
+
+func (d *decoder) multiSurfaceZ(b []byte) ([]byte, MultiSurfaceZ, error) {
+	b, dec, err := d.header(b, GeomMultiSurfaceZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomPolygonZ, GeomCurvePolygonZ)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (ms MultiSurfaceZ) IsEmpty() bool {
+	return isEmpty(ms)
+}
+
+func (ms MultiSurfaceZ) Kind() Kind {
+	return GeomMultiSurfaceZ
+}
+
+func (ms MultiSurfaceZ) Dimension() int {
+	return 2
+}
+
+func (ms MultiSurfaceZ) Envelope() Envelope {
+	return envelopeAll(ms)
+}
+
+func (ms MultiSurfaceZ) NumPoints() int {
+	return numPointsAll(ms)
+}
+
+func (ms MultiSurfaceZ) Equal(other Geometry) bool {
+	o, ok := other.(MultiSurfaceZ)
+	return ok && equalAll(ms, o)
+}
+
+// Centroid returns center of mass of linearized surfaces, empty point when it is empty.
+func (ms MultiSurfaceZ) Centroid() Point {
+	c := centroid{}
+	ms.addCentroid(&c)
+	return c.result()
+}
+
+func (ms MultiSurfaceZ) addCentroid(c *centroid) {
+	ms.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (ms MultiSurfaceZ) addShape(s *shape) {
+	ms.Linearize(DefaultSegments).addShape(s)
+}
+
+func (ms MultiSurfaceZ) ByteSize() int {
+	return membersSize(ms)
+}
+
+func (ms MultiSurfaceZ) Write(buf *bytes.Buffer) {
+	buf.Write(ms.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ms MultiSurfaceZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ms.encode(e)
+	return e.release()
+}
+
+func (ms MultiSurfaceZ) encode(e *encoder) {
+	e.members(GeomMultiSurfaceZ, ms)
+}
+
+// Scan decodes geometry into ps, reusing its backing arrays as UnmarshalTo does.
+func (ps *PolyhedralSurfaceZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ps.UnmarshalBinary(b)
+}
+
+func (ps PolyhedralSurfaceZ) Value() (driver.Value, error) {
+	return ps.MarshalBinary()
+}
+
+func (ps PolyhedralSurfaceZ) MarshalBinary() ([]byte, error) {
+	return ps.AppendWKB(make([]byte, 0, ps.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into ps, reusing its backing arrays as UnmarshalTo does.
+func (ps *PolyhedralSurfaceZ) UnmarshalBinary(b []byte) error {
+	return ps.unmarshal(newDecoder(b), b)
+}
+
+func (ps *PolyhedralSurfaceZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.polyhedralSurfaceZ(b, reuse(d, b, *ps))
+	if err != nil {
+		return err
+	}
+
+	*ps = tmp
+	return nil
+}
+
+func ReadPolyhedralSurfaceZ(b []byte) ([]byte, PolyhedralSurfaceZ, error) {
+	return newDecoder(b).polyhedralSurfaceZ(b, nil)
+}
+
+func (d *decoder) polyhedralSurfaceZ(b []byte, dst PolyhedralSurfaceZ) ([]byte, PolyhedralSurfaceZ, error) {
+	b, dec, err := d.header(b, GeomPolyhedralSurfaceZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, ps[i], err = d.polygonZ(b, ps[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, ps, nil
+}
+
+func (ps PolyhedralSurfaceZ) IsEmpty() bool {
+	return MultiPolygonZ(ps).IsEmpty()
+}
+
+func (ps PolyhedralSurfaceZ) Kind() Kind {
+	return GeomPolyhedralSurfaceZ
+}
+
+func (ps PolyhedralSurfaceZ) Dimension() int {
+	return 2
+}
+
+func (ps PolyhedralSurfaceZ) Envelope() Envelope {
+	return MultiPolygonZ(ps).Envelope()
+}
+
+func (ps PolyhedralSurfaceZ) NumPoints() int {
+	return MultiPolygonZ(ps).NumPoints()
+}
+
+func (ps PolyhedralSurfaceZ) Equal(other Geometry) bool {
+	o, ok := other.(PolyhedralSurfaceZ)
+	return ok && MultiPolygonZ(ps).Equal(MultiPolygonZ(o))
+}
+
+// Centroid returns center of mass of polygons projected to XY plane, empty point when it is empty.
+func (ps PolyhedralSurfaceZ) Centroid() Point {
+	c := centroid{}
+	ps.addCentroid(&c)
+	return c.result()
+}
+
+func (ps PolyhedralSurfaceZ) addCentroid(c *centroid) {
+	MultiPolygonZ(ps).addCentroid(c)
+}
+
+func (ps PolyhedralSurfaceZ) addShape(s *shape) {
+	MultiPolygonZ(ps).addShape(s)
+}
+
+func (ps PolyhedralSurfaceZ) ByteSize() int {
+	return MultiPolygonZ(ps).ByteSize()
+}
+
+func (ps PolyhedralSurfaceZ) Write(buf *bytes.Buffer) {
+	buf.Write(ps.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ps PolyhedralSurfaceZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ps.encode(e)
+	return e.release()
+}
+
+func (ps PolyhedralSurfaceZ) encode(e *encoder) {
+	e.header(GeomPolyhedralSurfaceZ)
+	e.count(len(ps))
+	for _, p := range ps {
+		p.encode(e)
+	}
+}
+
+// Scan decodes geometry into tin, reusing its backing arrays as UnmarshalTo does.
+func (tin *TINZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return tin.UnmarshalBinary(b)
+}
+
+func (tin TINZ) Value() (driver.Value, error) {
+	return tin.MarshalBinary()
+}
+
+func (tin TINZ) MarshalBinary() ([]byte, error) {
+	return tin.AppendWKB(make([]byte, 0, tin.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into tin, reusing its backing arrays as UnmarshalTo does.
+func (tin *TINZ) UnmarshalBinary(b []byte) error {
+	return tin.unmarshal(newDecoder(b), b)
+}
+
+func (tin *TINZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.tinZ(b, reuse(d, b, *tin))
+	if err != nil {
+		return err
+	}
+
+	*tin = tmp
+	return nil
+}
+
+func ReadTINZ(b []byte) ([]byte, TINZ, error) {
+	return newDecoder(b).tinZ(b, nil)
+}
+
+func (d *decoder) tinZ(b []byte, dst TINZ) ([]byte, TINZ, error) {
+	b, dec, err := d.header(b, GeomTINZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tin := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, tin[i], err = d.triangleZ(b, tin[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, tin, nil
+}
+
+func (tin TINZ) IsEmpty() bool {
+	for _, t := range tin {
+		if !t.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (tin TINZ) Kind() Kind {
+	return GeomTINZ
+}
+
+func (tin TINZ) Dimension() int {
+	return 2
+}
+
+func (tin TINZ) Envelope() Envelope {
+	return envelopeAll(tin)
+}
+
+func (tin TINZ) NumPoints() int {
+	return numPointsAll(tin)
+}
+
+func (tin TINZ) Equal(other Geometry) bool {
+	o, ok := other.(TINZ)
+	return ok && equalAll(tin, o)
+}
+
+// Centroid returns center of mass of triangles, empty point when it is empty.
+func (tin TINZ) Centroid() Point {
+	c := centroid{}
+	tin.addCentroid(&c)
+	return c.result()
+}
+
+func (tin TINZ) addCentroid(c *centroid) {
+	for _, t := range tin {
+		t.addCentroid(c)
+	}
+}
+
+func (tin TINZ) addShape(s *shape) {
+	for _, t := range tin {
+		t.addShape(s)
+	}
+}
+
+func (tin TINZ) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, t := range tin {
+		size += t.ByteSize()
+	}
+	return size
+}
+
+func (tin TINZ) Write(buf *bytes.Buffer) {
+	buf.Write(tin.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (tin TINZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	tin.encode(e)
+	return e.release()
+}
+
+func (tin TINZ) encode(e *encoder) {
+	e.header(GeomTINZ)
+	e.count(len(tin))
+	for _, t := range tin {
+		t.encode(e)
+	}
+}
+
+// Scan decodes geometry into t, reusing its backing arrays as UnmarshalTo does.
+func (t *TriangleZ) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalBinary(b)
+}
+
+func (t TriangleZ) Value() (driver.Value, error) {
+	return t.MarshalBinary()
+}
+
+func (t TriangleZ) MarshalBinary() ([]byte, error) {
+	return t.AppendWKB(make([]byte, 0, t.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into t, reusing its backing arrays as UnmarshalTo does.
+func (t *TriangleZ) UnmarshalBinary(b []byte) error {
+	return t.unmarshal(newDecoder(b), b)
+}
+
+func (t *TriangleZ) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.triangleZ(b, reuse(d, b, *t))
+	if err != nil {
+		return err
+	}
+
+	*t = tmp
+	return nil
+}
+
+func ReadTriangleZ(b []byte) ([]byte, TriangleZ, error) {
+	return newDecoder(b).triangleZ(b, nil)
+}
+
+func (d *decoder) triangleZ(b []byte, dst TriangleZ) ([]byte, TriangleZ, error) {
+	b, dec, err := d.header(b, GeomTriangleZ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, n, err := d.count(b, dec, CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n > 1 {
+		return nil, nil, d.fail(b, ErrInvalidStorage)
+	}
+	b = rest
+
+	t := grow(dst, n)
+	d.push("ring")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		rest, t[i], err = d.linearRingZ(b, dec, t[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if !validTriangle(t[i]) {
+			return nil, nil, d.fail(b, ErrInvalidStorage)
+		}
+		b = rest
+	}
+	d.pop()
+
+	return b, t, nil
+}
+
+func (t TriangleZ) IsEmpty() bool {
+	return PolygonZ(t).IsEmpty()
+}
+
+func (t TriangleZ) Kind() Kind {
+	return GeomTriangleZ
+}
+
+func (t TriangleZ) Dimension() int {
+	return 2
+}
+
+func (t TriangleZ) Envelope() Envelope {
+	return PolygonZ(t).Envelope()
+}
+
+func (t TriangleZ) NumPoints() int {
+	return PolygonZ(t).NumPoints()
+}
+
+func (t TriangleZ) Equal(other Geometry) bool {
+	o, ok := other.(TriangleZ)
+	return ok && PolygonZ(t).Equal(PolygonZ(o))
+}
+
+func (t TriangleZ) Area() float64 {
+	return PolygonZ(t).Area()
+}
+
+func (t TriangleZ) Perimeter() float64 {
+	return PolygonZ(t).Perimeter()
+}
+
+// Centroid returns center of mass of triangle, empty point when it is empty.
+func (t TriangleZ) Centroid() Point {
+	c := centroid{}
+	t.addCentroid(&c)
+	return c.result()
+}
+
+func (t TriangleZ) addCentroid(c *centroid) {
+	PolygonZ(t).addCentroid(c)
+}
+
+func (t TriangleZ) addShape(s *shape) {
+	PolygonZ(t).addShape(s)
+}
+
+func (t TriangleZ) ByteSize() int {
+	return PolygonZ(t).ByteSize()
+}
+
+func (t TriangleZ) Write(buf *bytes.Buffer) {
+	buf.Write(t.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (t TriangleZ) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	t.encode(e)
+	return e.release()
+}
+
+func (t TriangleZ) encode(e *encoder) {
+	e.header(GeomTriangleZ)
+	e.count(len(t))
+	for _, lr := range t {
+		lr.encode(e)
+	}
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (cp CurvePolygonZ) Linearize(segments int) PolygonZ {
+	poly := make(PolygonZ, len(cp))
+	for i, g := range cp {
+		poly[i] = LinearRingZ(linearizeCurveZ(g, segments))
+	}
+	return poly
+}
+
+func (cp CurvePolygonZ) linearize(segments int) Geometry {
+	return cp.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (ms MultiSurfaceZ) Linearize(segments int) MultiPolygonZ {
+	mp := make(MultiPolygonZ, len(ms))
+	for i, g := range ms {
+		switch s := g.(type) {
+		case PolygonZ:
+			mp[i] = s
+		case CurvePolygonZ:
+			mp[i] = s.Linearize(segments)
+		}
+	}
+	return mp
+}
+
+func (ms MultiSurfaceZ) linearize(segments int) Geometry {
+	return ms.Linearize(segments)
+}
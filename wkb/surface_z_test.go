@@ -0,0 +1,207 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawCurvePolygonZ = []byte{
+		0x01, 0xf2, 0x03, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x01, 0xf0, 0x03, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawMultiSurfaceZ = []byte{
+		0x01, 0xf4, 0x03, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numsurfaces - 2
+		0x01, 0xeb, 0x03, 0x00, 0x00, // polygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x01, 0xf2, 0x03, 0x00, 0x00, // curvepolygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x01, 0xf0, 0x03, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawPolyhedralSurfaceZ = []byte{
+		0x01, 0xf7, 0x03, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numpolygons - 1
+		0x01, 0xeb, 0x03, 0x00, 0x00, // polygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawTINZ = []byte{
+		0x01, 0xf8, 0x03, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numtriangles - 1
+		0x01, 0xf9, 0x03, 0x00, 0x00, // triangle
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+	rawTriangleZ = []byte{
+		0x01, 0xf9, 0x03, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+	}
+)
+
+func TestCurvePolygonZ(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawMultiSurfaceZ,
+		},
+		{
+			// invalid ring type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0xf2, 0x03, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numrings - 1
+				0x01, 0xeb, 0x03, 0x00, 0x00, // polygon
+				0x00, 0x00, 0x00, 0x00, // numrings - 0
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CurvePolygonZ{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cp := CurvePolygonZ{}
+	if err := cp.Scan(rawCurvePolygonZ); assert.NoError(t, err) {
+		assert.Equal(t, CurvePolygonZ{CircularStringZ{{0, 0, 5}, {2, 0, 5}, {0, 0, 5}}}, cp)
+	}
+
+	if raw, err := cp.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCurvePolygonZ, raw)
+		assert.Len(t, raw, cp.ByteSize())
+	}
+}
+
+func TestMultiSurfaceZ(t *testing.T) {
+	ms := MultiSurfaceZ{}
+	if err := ms.Scan(rawMultiSurfaceZ); assert.NoError(t, err) {
+		assert.Equal(t, MultiSurfaceZ{
+			PolygonZ{{{0, 0, 5}, {1, 0, 5}, {0, 1, 5}, {0, 0, 5}}},
+			CurvePolygonZ{CircularStringZ{{0, 0, 5}, {2, 0, 5}, {0, 0, 5}}},
+		}, ms)
+	}
+
+	if raw, err := ms.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiSurfaceZ, raw)
+		assert.Len(t, raw, ms.ByteSize())
+	}
+}
+
+func TestPolyhedralSurfaceZ(t *testing.T) {
+	ps := PolyhedralSurfaceZ{}
+	if err := ps.Scan(rawPolyhedralSurfaceZ); assert.NoError(t, err) {
+		assert.Equal(t, PolyhedralSurfaceZ{{{{0, 0, 5}, {1, 0, 5}, {0, 1, 5}, {0, 0, 5}}}}, ps)
+	}
+
+	if raw, err := ps.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawPolyhedralSurfaceZ, raw)
+		assert.Len(t, raw, ps.ByteSize())
+	}
+}
+
+func TestTINZ(t *testing.T) {
+	// polyhedral surface members are not triangles
+	if err := (&TINZ{}).Scan(rawPolyhedralSurfaceZ); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	tin := TINZ{}
+	if err := tin.Scan(rawTINZ); assert.NoError(t, err) {
+		assert.Equal(t, TINZ{{{{0, 0, 5}, {1, 0, 5}, {0, 1, 5}, {0, 0, 5}}}}, tin)
+	}
+
+	if raw, err := tin.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawTINZ, raw)
+		assert.Len(t, raw, tin.ByteSize())
+	}
+}
+
+func TestTriangleZ(t *testing.T) {
+	tr := TriangleZ{}
+	if err := tr.Scan(rawTriangleZ); assert.NoError(t, err) {
+		assert.Equal(t, TriangleZ{{{0, 0, 5}, {1, 0, 5}, {0, 1, 5}, {0, 0, 5}}}, tr)
+	}
+
+	if raw, err := tr.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawTriangleZ, raw)
+		assert.Len(t, raw, tr.ByteSize())
+	}
+}
@@ -0,0 +1,646 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+func (cp *CurvePolygonZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return cp.UnmarshalBinary(b)
+}
+
+func (cp CurvePolygonZM) Value() (driver.Value, error) {
+	return cp.MarshalBinary()
+}
+
+func (cp CurvePolygonZM) MarshalBinary() ([]byte, error) {
+	return cp.AppendWKB(make([]byte, 0, cp.ByteSize())), nil
+}
+
+func (cp *CurvePolygonZM) UnmarshalBinary(b []byte) error {
+	return cp.unmarshal(newDecoder(b), b)
+}
+
+func (cp *CurvePolygonZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.curvePolygonZM(b)
+	if err != nil {
+		return err
+	}
+
+	*cp = tmp
+	return nil
+}
+
+func ReadCurvePolygonZM(b []byte) ([]byte, CurvePolygonZM, error) {
+	return newDecoder(b).curvePolygonZM(b)
+}
+
+// curvePolygonZM reads polygon with rings made of any curves.
+func (d *decoder) curvePolygonZM(b []byte) ([]byte, CurvePolygonZM, error) {
+	b, dec, err := d.header(b, GeomCurvePolygonZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "ring", GeomLineStringZM, GeomCircularStringZM, GeomCompoundCurveZM)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (cp CurvePolygonZM) IsEmpty() bool {
+	return isEmpty(cp)
+}
+
+func (cp CurvePolygonZM) Kind() Kind {
+	return GeomCurvePolygonZM
+}
+
+func (cp CurvePolygonZM) Dimension() int {
+	return 2
+}
+
+func (cp CurvePolygonZM) Envelope() Envelope {
+	return envelopeAll(cp)
+}
+
+func (cp CurvePolygonZM) NumPoints() int {
+	return numPointsAll(cp)
+}
+
+func (cp CurvePolygonZM) Equal(other Geometry) bool {
+	o, ok := other.(CurvePolygonZM)
+	return ok && equalAll(cp, o)
+}
+
+// Centroid returns center of mass of linearized polygon, empty point when it is empty.
+func (cp CurvePolygonZM) Centroid() Point {
+	c := centroid{}
+	cp.addCentroid(&c)
+	return c.result()
+}
+
+func (cp CurvePolygonZM) addCentroid(c *centroid) {
+	cp.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (cp CurvePolygonZM) addShape(s *shape) {
+	cp.Linearize(DefaultSegments).addShape(s)
+}
+
+func (cp CurvePolygonZM) ByteSize() int {
+	return membersSize(cp)
+}
+
+func (cp CurvePolygonZM) Write(buf *bytes.Buffer) {
+	buf.Write(cp.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (cp CurvePolygonZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	cp.encode(e)
+	return e.release()
+}
+
+func (cp CurvePolygonZM) encode(e *encoder) {
+	e.members(GeomCurvePolygonZM, cp)
+}
+
+func (ms *MultiSurfaceZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ms.UnmarshalBinary(b)
+}
+
+func (ms MultiSurfaceZM) Value() (driver.Value, error) {
+	return ms.MarshalBinary()
+}
+
+func (ms MultiSurfaceZM) MarshalBinary() ([]byte, error) {
+	return ms.AppendWKB(make([]byte, 0, ms.ByteSize())), nil
+}
+
+func (ms *MultiSurfaceZM) UnmarshalBinary(b []byte) error {
+	return ms.unmarshal(newDecoder(b), b)
+}
+
+func (ms *MultiSurfaceZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.multiSurfaceZM(b)
+	if err != nil {
+		return err
+	}
+
+	*ms = tmp
+	return nil
+}
+
+func ReadMultiSurfaceZM(b []byte) ([]byte, MultiSurfaceZM, error) {
+	return newDecoder(b).multiSurfaceZM(b)
+}
+
+func (d *decoder) multiSurfaceZM(b []byte) ([]byte, MultiSurfaceZM, error) {
+	b, dec, err := d.header(b, GeomMultiSurfaceZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, gs, err := d.members(b, dec, "", GeomPolygonZM, GeomCurvePolygonZM)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, gs, nil
+}
+
+func (ms MultiSurfaceZM) IsEmpty() bool {
+	return isEmpty(ms)
+}
+
+func (ms MultiSurfaceZM) Kind() Kind {
+	return GeomMultiSurfaceZM
+}
+
+func (ms MultiSurfaceZM) Dimension() int {
+	return 2
+}
+
+func (ms MultiSurfaceZM) Envelope() Envelope {
+	return envelopeAll(ms)
+}
+
+func (ms MultiSurfaceZM) NumPoints() int {
+	return numPointsAll(ms)
+}
+
+func (ms MultiSurfaceZM) Equal(other Geometry) bool {
+	o, ok := other.(MultiSurfaceZM)
+	return ok && equalAll(ms, o)
+}
+
+// Centroid returns center of mass of linearized surfaces, empty point when it is empty.
+func (ms MultiSurfaceZM) Centroid() Point {
+	c := centroid{}
+	ms.addCentroid(&c)
+	return c.result()
+}
+
+func (ms MultiSurfaceZM) addCentroid(c *centroid) {
+	ms.Linearize(DefaultSegments).addCentroid(c)
+}
+
+func (ms MultiSurfaceZM) addShape(s *shape) {
+	ms.Linearize(DefaultSegments).addShape(s)
+}
+
+func (ms MultiSurfaceZM) ByteSize() int {
+	return membersSize(ms)
+}
+
+func (ms MultiSurfaceZM) Write(buf *bytes.Buffer) {
+	buf.Write(ms.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ms MultiSurfaceZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ms.encode(e)
+	return e.release()
+}
+
+func (ms MultiSurfaceZM) encode(e *encoder) {
+	e.members(GeomMultiSurfaceZM, ms)
+}
+
+// Scan decodes geometry into ps, reusing its backing arrays as UnmarshalTo does.
+func (ps *PolyhedralSurfaceZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return ps.UnmarshalBinary(b)
+}
+
+func (ps PolyhedralSurfaceZM) Value() (driver.Value, error) {
+	return ps.MarshalBinary()
+}
+
+func (ps PolyhedralSurfaceZM) MarshalBinary() ([]byte, error) {
+	return ps.AppendWKB(make([]byte, 0, ps.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into ps, reusing its backing arrays as UnmarshalTo does.
+func (ps *PolyhedralSurfaceZM) UnmarshalBinary(b []byte) error {
+	return ps.unmarshal(newDecoder(b), b)
+}
+
+func (ps *PolyhedralSurfaceZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.polyhedralSurfaceZM(b, reuse(d, b, *ps))
+	if err != nil {
+		return err
+	}
+
+	*ps = tmp
+	return nil
+}
+
+func ReadPolyhedralSurfaceZM(b []byte) ([]byte, PolyhedralSurfaceZM, error) {
+	return newDecoder(b).polyhedralSurfaceZM(b, nil)
+}
+
+func (d *decoder) polyhedralSurfaceZM(b []byte, dst PolyhedralSurfaceZM) ([]byte, PolyhedralSurfaceZM, error) {
+	b, dec, err := d.header(b, GeomPolyhedralSurfaceZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, ps[i], err = d.polygonZM(b, ps[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, ps, nil
+}
+
+func (ps PolyhedralSurfaceZM) IsEmpty() bool {
+	return MultiPolygonZM(ps).IsEmpty()
+}
+
+func (ps PolyhedralSurfaceZM) Kind() Kind {
+	return GeomPolyhedralSurfaceZM
+}
+
+func (ps PolyhedralSurfaceZM) Dimension() int {
+	return 2
+}
+
+func (ps PolyhedralSurfaceZM) Envelope() Envelope {
+	return MultiPolygonZM(ps).Envelope()
+}
+
+func (ps PolyhedralSurfaceZM) NumPoints() int {
+	return MultiPolygonZM(ps).NumPoints()
+}
+
+func (ps PolyhedralSurfaceZM) Equal(other Geometry) bool {
+	o, ok := other.(PolyhedralSurfaceZM)
+	return ok && MultiPolygonZM(ps).Equal(MultiPolygonZM(o))
+}
+
+// Centroid returns center of mass of polygons projected to XY plane, empty point when it is empty.
+func (ps PolyhedralSurfaceZM) Centroid() Point {
+	c := centroid{}
+	ps.addCentroid(&c)
+	return c.result()
+}
+
+func (ps PolyhedralSurfaceZM) addCentroid(c *centroid) {
+	MultiPolygonZM(ps).addCentroid(c)
+}
+
+func (ps PolyhedralSurfaceZM) addShape(s *shape) {
+	MultiPolygonZM(ps).addShape(s)
+}
+
+func (ps PolyhedralSurfaceZM) ByteSize() int {
+	return MultiPolygonZM(ps).ByteSize()
+}
+
+func (ps PolyhedralSurfaceZM) Write(buf *bytes.Buffer) {
+	buf.Write(ps.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (ps PolyhedralSurfaceZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	ps.encode(e)
+	return e.release()
+}
+
+func (ps PolyhedralSurfaceZM) encode(e *encoder) {
+	e.header(GeomPolyhedralSurfaceZM)
+	e.count(len(ps))
+	for _, p := range ps {
+		p.encode(e)
+	}
+}
+
+// Scan decodes geometry into tin, reusing its backing arrays as UnmarshalTo does.
+func (tin *TINZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return tin.UnmarshalBinary(b)
+}
+
+func (tin TINZM) Value() (driver.Value, error) {
+	return tin.MarshalBinary()
+}
+
+func (tin TINZM) MarshalBinary() ([]byte, error) {
+	return tin.AppendWKB(make([]byte, 0, tin.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into tin, reusing its backing arrays as UnmarshalTo does.
+func (tin *TINZM) UnmarshalBinary(b []byte) error {
+	return tin.unmarshal(newDecoder(b), b)
+}
+
+func (tin *TINZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.tinZM(b, reuse(d, b, *tin))
+	if err != nil {
+		return err
+	}
+
+	*tin = tmp
+	return nil
+}
+
+func ReadTINZM(b []byte) ([]byte, TINZM, error) {
+	return newDecoder(b).tinZM(b, nil)
+}
+
+func (d *decoder) tinZM(b []byte, dst TINZM) ([]byte, TINZM, error) {
+	b, dec, err := d.header(b, GeomTINZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, n, err := d.count(b, dec, HeaderSize+CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tin := grow(dst, n)
+	d.push("")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		b, tin[i], err = d.triangleZM(b, tin[i])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	d.pop()
+
+	return b, tin, nil
+}
+
+func (tin TINZM) IsEmpty() bool {
+	for _, t := range tin {
+		if !t.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (tin TINZM) Kind() Kind {
+	return GeomTINZM
+}
+
+func (tin TINZM) Dimension() int {
+	return 2
+}
+
+func (tin TINZM) Envelope() Envelope {
+	return envelopeAll(tin)
+}
+
+func (tin TINZM) NumPoints() int {
+	return numPointsAll(tin)
+}
+
+func (tin TINZM) Equal(other Geometry) bool {
+	o, ok := other.(TINZM)
+	return ok && equalAll(tin, o)
+}
+
+// Centroid returns center of mass of triangles, empty point when it is empty.
+func (tin TINZM) Centroid() Point {
+	c := centroid{}
+	tin.addCentroid(&c)
+	return c.result()
+}
+
+func (tin TINZM) addCentroid(c *centroid) {
+	for _, t := range tin {
+		t.addCentroid(c)
+	}
+}
+
+func (tin TINZM) addShape(s *shape) {
+	for _, t := range tin {
+		t.addShape(s)
+	}
+}
+
+func (tin TINZM) ByteSize() int {
+	size := HeaderSize + CountSize
+	for _, t := range tin {
+		size += t.ByteSize()
+	}
+	return size
+}
+
+func (tin TINZM) Write(buf *bytes.Buffer) {
+	buf.Write(tin.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (tin TINZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	tin.encode(e)
+	return e.release()
+}
+
+func (tin TINZM) encode(e *encoder) {
+	e.header(GeomTINZM)
+	e.count(len(tin))
+	for _, t := range tin {
+		t.encode(e)
+	}
+}
+
+// Scan decodes geometry into t, reusing its backing arrays as UnmarshalTo does.
+func (t *TriangleZM) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return t.UnmarshalBinary(b)
+}
+
+func (t TriangleZM) Value() (driver.Value, error) {
+	return t.MarshalBinary()
+}
+
+func (t TriangleZM) MarshalBinary() ([]byte, error) {
+	return t.AppendWKB(make([]byte, 0, t.ByteSize())), nil
+}
+
+// UnmarshalBinary decodes geometry into t, reusing its backing arrays as UnmarshalTo does.
+func (t *TriangleZM) UnmarshalBinary(b []byte) error {
+	return t.unmarshal(newDecoder(b), b)
+}
+
+func (t *TriangleZM) unmarshal(d *decoder, b []byte) error {
+	_, tmp, err := d.triangleZM(b, reuse(d, b, *t))
+	if err != nil {
+		return err
+	}
+
+	*t = tmp
+	return nil
+}
+
+func ReadTriangleZM(b []byte) ([]byte, TriangleZM, error) {
+	return newDecoder(b).triangleZM(b, nil)
+}
+
+func (d *decoder) triangleZM(b []byte, dst TriangleZM) ([]byte, TriangleZM, error) {
+	b, dec, err := d.header(b, GeomTriangleZM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rest, n, err := d.count(b, dec, CountSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n > 1 {
+		return nil, nil, d.fail(b, ErrInvalidStorage)
+	}
+	b = rest
+
+	t := grow(dst, n)
+	d.push("ring")
+	for i := 0; i < n; i++ {
+		d.at(i)
+		rest, t[i], err = d.linearRingZM(b, dec, t[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if !validTriangle(t[i]) {
+			return nil, nil, d.fail(b, ErrInvalidStorage)
+		}
+		b = rest
+	}
+	d.pop()
+
+	return b, t, nil
+}
+
+func (t TriangleZM) IsEmpty() bool {
+	return PolygonZM(t).IsEmpty()
+}
+
+func (t TriangleZM) Kind() Kind {
+	return GeomTriangleZM
+}
+
+func (t TriangleZM) Dimension() int {
+	return 2
+}
+
+func (t TriangleZM) Envelope() Envelope {
+	return PolygonZM(t).Envelope()
+}
+
+func (t TriangleZM) NumPoints() int {
+	return PolygonZM(t).NumPoints()
+}
+
+func (t TriangleZM) Equal(other Geometry) bool {
+	o, ok := other.(TriangleZM)
+	return ok && PolygonZM(t).Equal(PolygonZM(o))
+}
+
+func (t TriangleZM) Area() float64 {
+	return PolygonZM(t).Area()
+}
+
+func (t TriangleZM) Perimeter() float64 {
+	return PolygonZM(t).Perimeter()
+}
+
+// Centroid returns center of mass of triangle, empty point when it is empty.
+func (t TriangleZM) Centroid() Point {
+	c := centroid{}
+	t.addCentroid(&c)
+	return c.result()
+}
+
+func (t TriangleZM) addCentroid(c *centroid) {
+	PolygonZM(t).addCentroid(c)
+}
+
+func (t TriangleZM) addShape(s *shape) {
+	PolygonZM(t).addShape(s)
+}
+
+func (t TriangleZM) ByteSize() int {
+	return PolygonZM(t).ByteSize()
+}
+
+func (t TriangleZM) Write(buf *bytes.Buffer) {
+	buf.Write(t.AppendWKB(buf.AvailableBuffer()))
+}
+
+func (t TriangleZM) AppendWKB(dst []byte) []byte {
+	e := appendEncoder(dst)
+	t.encode(e)
+	return e.release()
+}
+
+func (t TriangleZM) encode(e *encoder) {
+	e.header(GeomTriangleZM)
+	e.count(len(t))
+	for _, lr := range t {
+		lr.encode(e)
+	}
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (cp CurvePolygonZM) Linearize(segments int) PolygonZM {
+	poly := make(PolygonZM, len(cp))
+	for i, g := range cp {
+		poly[i] = LinearRingZM(linearizeCurveZM(g, segments))
+	}
+	return poly
+}
+
+func (cp CurvePolygonZM) linearize(segments int) Geometry {
+	return cp.Linearize(segments)
+}
+
+// Linearize approximates arcs with line segments, using given number of segments per quarter circle.
+func (ms MultiSurfaceZM) Linearize(segments int) MultiPolygonZM {
+	mp := make(MultiPolygonZM, len(ms))
+	for i, g := range ms {
+		switch s := g.(type) {
+		case PolygonZM:
+			mp[i] = s
+		case CurvePolygonZM:
+			mp[i] = s.Linearize(segments)
+		}
+	}
+	return mp
+}
+
+func (ms MultiSurfaceZM) linearize(segments int) Geometry {
+	return ms.Linearize(segments)
+}
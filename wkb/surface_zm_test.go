@@ -0,0 +1,229 @@
+package wkb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	rawCurvePolygonZM = []byte{
+		0x01, 0xc2, 0x0b, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x01, 0xc0, 0x0b, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	}
+	rawMultiSurfaceZM = []byte{
+		0x01, 0xc4, 0x0b, 0x00, 0x00, // header
+		0x02, 0x00, 0x00, 0x00, // numsurfaces - 2
+		0x01, 0xbb, 0x0b, 0x00, 0x00, // polygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x01, 0xc2, 0x0b, 0x00, 0x00, // curvepolygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x01, 0xc0, 0x0b, 0x00, 0x00, // circularstring
+		0x03, 0x00, 0x00, 0x00, // numpoints - 3
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	}
+	rawPolyhedralSurfaceZM = []byte{
+		0x01, 0xc7, 0x0b, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numpolygons - 1
+		0x01, 0xbb, 0x0b, 0x00, 0x00, // polygon
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	}
+	rawTINZM = []byte{
+		0x01, 0xc8, 0x0b, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numtriangles - 1
+		0x01, 0xc9, 0x0b, 0x00, 0x00, // triangle
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	}
+	rawTriangleZM = []byte{
+		0x01, 0xc9, 0x0b, 0x00, 0x00, // header
+		0x01, 0x00, 0x00, 0x00, // numrings - 1
+		0x04, 0x00, 0x00, 0x00, // numpoints - 4
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x40,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x40,
+	}
+)
+
+func TestCurvePolygonZM(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{
+			// invalid type
+			ErrUnsupportedValue,
+			rawMultiSurfaceZM,
+		},
+		{
+			// invalid ring type
+			ErrUnsupportedValue,
+			[]byte{
+				0x01, 0xc2, 0x0b, 0x00, 0x00, // header
+				0x01, 0x00, 0x00, 0x00, // numrings - 1
+				0x01, 0xbb, 0x0b, 0x00, 0x00, // polygon
+				0x00, 0x00, 0x00, 0x00, // numrings - 0
+			},
+		},
+	}
+
+	for _, e := range invalid {
+		if err := (&CurvePolygonZM{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	cp := CurvePolygonZM{}
+	if err := cp.Scan(rawCurvePolygonZM); assert.NoError(t, err) {
+		assert.Equal(t, CurvePolygonZM{CircularStringZM{{0, 0, 5, 7}, {2, 0, 5, 7}, {0, 0, 5, 7}}}, cp)
+	}
+
+	if raw, err := cp.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawCurvePolygonZM, raw)
+		assert.Len(t, raw, cp.ByteSize())
+	}
+}
+
+func TestMultiSurfaceZM(t *testing.T) {
+	ms := MultiSurfaceZM{}
+	if err := ms.Scan(rawMultiSurfaceZM); assert.NoError(t, err) {
+		assert.Equal(t, MultiSurfaceZM{
+			PolygonZM{{{0, 0, 5, 7}, {1, 0, 5, 7}, {0, 1, 5, 7}, {0, 0, 5, 7}}},
+			CurvePolygonZM{CircularStringZM{{0, 0, 5, 7}, {2, 0, 5, 7}, {0, 0, 5, 7}}},
+		}, ms)
+	}
+
+	if raw, err := ms.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiSurfaceZM, raw)
+		assert.Len(t, raw, ms.ByteSize())
+	}
+}
+
+func TestPolyhedralSurfaceZM(t *testing.T) {
+	ps := PolyhedralSurfaceZM{}
+	if err := ps.Scan(rawPolyhedralSurfaceZM); assert.NoError(t, err) {
+		assert.Equal(t, PolyhedralSurfaceZM{{{{0, 0, 5, 7}, {1, 0, 5, 7}, {0, 1, 5, 7}, {0, 0, 5, 7}}}}, ps)
+	}
+
+	if raw, err := ps.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawPolyhedralSurfaceZM, raw)
+		assert.Len(t, raw, ps.ByteSize())
+	}
+}
+
+func TestTINZM(t *testing.T) {
+	// polyhedral surface members are not triangles
+	if err := (&TINZM{}).Scan(rawPolyhedralSurfaceZM); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	tin := TINZM{}
+	if err := tin.Scan(rawTINZM); assert.NoError(t, err) {
+		assert.Equal(t, TINZM{{{{0, 0, 5, 7}, {1, 0, 5, 7}, {0, 1, 5, 7}, {0, 0, 5, 7}}}}, tin)
+	}
+
+	if raw, err := tin.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawTINZM, raw)
+		assert.Len(t, raw, tin.ByteSize())
+	}
+}
+
+func TestTriangleZM(t *testing.T) {
+	tr := TriangleZM{}
+	if err := tr.Scan(rawTriangleZM); assert.NoError(t, err) {
+		assert.Equal(t, TriangleZM{{{0, 0, 5, 7}, {1, 0, 5, 7}, {0, 1, 5, 7}, {0, 0, 5, 7}}}, tr)
+	}
+
+	if raw, err := tr.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawTriangleZM, raw)
+		assert.Len(t, raw, tr.ByteSize())
+	}
+}
@@ -0,0 +1,379 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"math"
+)
+
+// View is WKB geometry read lazily from its bytes, without decoding points into slices.
+// Scan and Value pass bytes through unchanged. Structure of View is checked by NewView and Scan,
+// accessors of View converted from unchecked bytes return zero values when it is malformed.
+type View []byte
+
+// NewView checks structure of WKB geometry and returns View referencing b.
+func NewView(b []byte) (View, error) {
+	v := View(b)
+	if err := v.validate(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Scan copies geometry into View, reusing its capacity.
+func (v *View) Scan(src interface{}) error {
+	b, err := scanBytes(src)
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalBinary(b)
+}
+
+func (v View) Value() (driver.Value, error) {
+	return []byte(v), nil
+}
+
+func (v View) MarshalBinary() ([]byte, error) {
+	return v.AppendWKB(nil), nil
+}
+
+func (v *View) UnmarshalBinary(b []byte) error {
+	if err := View(b).validate(); err != nil {
+		return err
+	}
+
+	*v = append((*v)[:0], b...)
+	return nil
+}
+
+func (v View) validate() error {
+	dec, k, off, ok := v.body()
+	if !ok {
+		return ErrInvalidStorage
+	}
+
+	_, err := walk(v, off, dec, k, nil, nil)
+	return err
+}
+
+// body returns byte order, kind and offset of body of geometry, skipping SRID of EWKB.
+func (v View) body() (binary.ByteOrder, Kind, int, bool) {
+	if len(v) < HeaderSize {
+		return nil, 0, 0, false
+	}
+
+	dec := byteOrder(v[0])
+	if dec == nil {
+		return nil, 0, 0, false
+	}
+
+	code := dec.Uint32(v[ByteOrderSize:])
+	off := HeaderSize
+	if code&ewkbSRID != 0 {
+		off += SRIDSize
+	}
+	if len(v) < off {
+		return nil, 0, 0, false
+	}
+	return dec, kind(code), off, true
+}
+
+// Decode reads geometry from View.
+func (v View) Decode() (Geometry, error) {
+	return Unmarshal(v)
+}
+
+func (v View) Kind() Kind {
+	_, k, _, _ := v.body()
+	return k
+}
+
+// NumPoints returns number of points in geometry, including its rings and members.
+func (v View) NumPoints() int {
+	switch v.Kind() % 1000 {
+	case GeomPoint:
+		c := v.Coords()
+		if c.Len() == 0 {
+			return 0
+		}
+		if x, y := c.XY(0); math.IsNaN(x) && math.IsNaN(y) {
+			return 0
+		}
+		return 1
+	case GeomLineString, GeomCircularString:
+		return v.Coords().Len()
+	case GeomPolygon, GeomTriangle:
+		n := 0
+		for i := 0; i < v.NumRings(); i++ {
+			n += v.Ring(i).Len()
+		}
+		return n
+	default:
+		n := 0
+		v.members(func(m View) bool {
+			n += m.NumPoints()
+			return true
+		})
+		return n
+	}
+}
+
+// Envelope returns bounding box of geometry. Circular strings are decoded to find it,
+// members of geometries which may contain them are visited one by one.
+func (v View) Envelope() Envelope {
+	dec, k, off, ok := v.body()
+	if !ok {
+		return EmptyEnvelope()
+	}
+
+	e := EmptyEnvelope()
+	switch k % 1000 {
+	case GeomCircularString:
+		g, err := v.Decode()
+		if err != nil {
+			return e
+		}
+		return g.Envelope()
+	case GeomCompoundCurve, GeomCurvePolygon, GeomMultiCurve, GeomMultiSurface, GeomCollection:
+		v.members(func(m View) bool {
+			e = e.Union(m.Envelope())
+			return true
+		})
+		return e
+	}
+
+	walk(v, off, dec, k, nil, func(x, y float64) {
+		e = e.add(x, y)
+	})
+	return e
+}
+
+func (v View) Dimension() int {
+	switch v.Kind() % 1000 {
+	case GeomPoint, GeomMultiPoint:
+		return 0
+	case GeomLineString, GeomCircularString, GeomCompoundCurve, GeomMultiLineString, GeomMultiCurve:
+		return 1
+	case GeomCollection:
+		dim := 0
+		v.members(func(m View) bool {
+			dim = max(dim, m.Dimension())
+			return true
+		})
+		return dim
+	default:
+		return 2
+	}
+}
+
+func (v View) IsEmpty() bool {
+	return v.NumPoints() == 0
+}
+
+// Equal reports whether geometry of View equals other, decoding both unless they have same bytes.
+func (v View) Equal(other Geometry) bool {
+	if o, ok := other.(View); ok {
+		if bytes.Equal(v, o) {
+			return true
+		}
+
+		g, err := o.Decode()
+		if err != nil {
+			return false
+		}
+		other = g
+	}
+
+	g, err := v.Decode()
+	return err == nil && g.Equal(other)
+}
+
+// NumGeometries returns number of members of multi geometry, collection or curve made of other geometries.
+func (v View) NumGeometries() int {
+	switch v.Kind() % 1000 {
+	case GeomPoint, GeomLineString, GeomCircularString, GeomPolygon, GeomTriangle:
+		return 0
+	default:
+		return v.count()
+	}
+}
+
+// Geometries returns members of multi geometry, collection or curve made of other geometries,
+// read in single pass. Members of malformed view are returned up to first malformed one.
+func (v View) Geometries() []View {
+	var gs []View
+	v.members(func(m View) bool {
+		gs = append(gs, m)
+		return true
+	})
+	return gs
+}
+
+// Geometry returns i-th member of multi geometry, collection or curve made of other geometries.
+// Members preceding it are walked to find it, so Geometries should be used to visit all of them.
+func (v View) Geometry(i int) View {
+	var g View
+	j := 0
+	v.members(func(m View) bool {
+		if j == i {
+			g = m
+			return false
+		}
+		j++
+		return true
+	})
+	return g
+}
+
+// members calls fn with each member of multi geometry, collection or curve made of other geometries
+// in turn, until it returns false. Members are read in their own byte order.
+func (v View) members(fn func(m View) bool) {
+	_, _, off, ok := v.body()
+	n := v.NumGeometries()
+	if !ok || n <= 0 {
+		return
+	}
+
+	off += CountSize
+	for i := 0; i < n; i++ {
+		if len(v)-off < HeaderSize {
+			return
+		}
+
+		dec := byteOrder(v[off])
+		if dec == nil {
+			return
+		}
+		_, code := readUint32(v[off+ByteOrderSize:], dec)
+		end, err := walk(v, off+HeaderSize, dec, kind(code), nil, nil)
+		if err != nil || !fn(v[off:end]) {
+			return
+		}
+		off = end
+	}
+}
+
+// NumRings returns number of rings of polygon or triangle.
+func (v View) NumRings() int {
+	switch v.Kind() % 1000 {
+	case GeomPolygon, GeomTriangle:
+		return v.count()
+	default:
+		return 0
+	}
+}
+
+// Ring returns points of i-th ring of polygon or triangle.
+func (v View) Ring(i int) Coords {
+	dec, k, off, ok := v.body()
+	if !ok || i < 0 || i >= v.NumRings() {
+		return Coords{}
+	}
+
+	size, _ := coordSize(k)
+	off += CountSize
+	for j := 0; ; j++ {
+		c, ok := v.coords(off, dec, size)
+		if !ok || j == i {
+			return c
+		}
+		off += CountSize + len(c.b)
+	}
+}
+
+// Coords returns points of point, line string or circular string.
+func (v View) Coords() Coords {
+	dec, k, off, ok := v.body()
+	if !ok {
+		return Coords{}
+	}
+
+	size, _ := coordSize(k)
+	switch k % 1000 {
+	case GeomPoint:
+		if len(v)-off < size {
+			return Coords{}
+		}
+		return Coords{v[off : off+size], dec, size}
+	case GeomLineString, GeomCircularString:
+		c, _ := v.coords(off, dec, size)
+		return c
+	default:
+		return Coords{}
+	}
+}
+
+// coords returns sequence of points preceded by count at b[off:].
+func (v View) coords(off int, dec binary.ByteOrder, size int) (Coords, bool) {
+	if len(v)-off < CountSize {
+		return Coords{}, false
+	}
+
+	_, n := readCount(v[off:], dec)
+	off += CountSize
+	if n < 0 || n > (len(v)-off)/size {
+		return Coords{}, false
+	}
+	return Coords{v[off : off+n*size], dec, size}, true
+}
+
+func (v View) count() int {
+	dec, _, off, ok := v.body()
+	if !ok || len(v)-off < CountSize {
+		return 0
+	}
+
+	_, n := readCount(v[off:], dec)
+	return n
+}
+
+func (v View) ByteSize() int {
+	return len(v)
+}
+
+func (v View) Write(buf *bytes.Buffer) {
+	buf.Write(v)
+}
+
+func (v View) AppendWKB(dst []byte) []byte {
+	return append(dst, v...)
+}
+
+func (v View) encode(e *encoder) {
+	e.buf = append(e.buf, v...)
+}
+
+// Coords is sequence of points within WKB, decoded on access.
+type Coords struct {
+	b    []byte
+	dec  binary.ByteOrder
+	size int
+}
+
+func (c Coords) Len() int {
+	if c.size == 0 {
+		return 0
+	}
+	return len(c.b) / c.size
+}
+
+// NumOrdinates returns number of ordinates of each point.
+func (c Coords) NumOrdinates() int {
+	return c.size / Float64Size
+}
+
+func (c Coords) XY(i int) (x, y float64) {
+	_, x = readFloat64(c.b[i*c.size:], c.dec)
+	_, y = readFloat64(c.b[i*c.size+Float64Size:], c.dec)
+	return x, y
+}
+
+// Ordinates appends all ordinates of i-th point to dst, in order they are stored.
+func (c Coords) Ordinates(dst []float64, i int) []float64 {
+	for off := i * c.size; off < (i+1)*c.size; off += Float64Size {
+		_, f := readFloat64(c.b[off:], c.dec)
+		dst = append(dst, f)
+	}
+	return dst
+}
@@ -0,0 +1,144 @@
+package wkb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestView(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{ErrInvalidStorage, []byte{0x01, 0x02}},
+		{ErrInvalidStorage, []byte{0x02, 0x01, 0x00, 0x00, 0x00}},
+		{ErrUnsupportedValue, []byte{0x01, 0x63, 0x00, 0x00, 0x00}},
+		{ErrInvalidStorage, rawMultiPolygon[:len(rawMultiPolygon)-1]},
+	}
+
+	for _, e := range invalid {
+		if _, err := NewView(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+		if err := (&View{}).Scan(e.b); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	v := View{}
+	if assert.NoError(t, v.Scan(rawMultiPolygon)) {
+		assert.Equal(t, View(rawMultiPolygon), v)
+		assert.Equal(t, Kind(GeomMultiPolygon), v.Kind())
+		assert.Equal(t, 2, v.NumGeometries())
+		assert.Equal(t, 0, v.NumRings())
+		assert.Equal(t, 0, v.Coords().Len())
+
+		g, err := v.Decode()
+		if assert.NoError(t, err) {
+			mp := g.(MultiPolygon)
+			assert.Equal(t, len(mp[0][0])+len(mp[1][0]), v.NumPoints())
+
+			p := v.Geometry(1)
+			assert.Equal(t, Kind(GeomPolygon), p.Kind())
+			assert.Equal(t, 1, p.NumRings())
+			assert.Equal(t, Marshal(mp[1]), []byte(p))
+
+			ring := p.Ring(0)
+			if assert.Equal(t, len(mp[1][0]), ring.Len()) {
+				x, y := ring.XY(2)
+				assert.Equal(t, mp[1][0][2], Point{x, y})
+			}
+		}
+		assert.Nil(t, v.Geometry(2))
+		assert.Equal(t, Coords{}, v.Geometry(0).Ring(1))
+		assert.Equal(t, []View{v.Geometry(0), v.Geometry(1)}, v.Geometries())
+
+		assert.Equal(t, Envelope{5, 5, 45, 40}, v.Envelope())
+		assert.Equal(t, 2, v.Dimension())
+		assert.False(t, v.IsEmpty())
+		assert.True(t, v.Equal(g))
+		assert.True(t, v.Equal(View(Marshal(g, WithByteOrder(BigEndian)))))
+		assert.False(t, v.Equal(v.Geometry(0)))
+	}
+
+	if raw, err := v.Value(); assert.NoError(t, err) {
+		assert.Equal(t, rawMultiPolygon, raw)
+	}
+
+	// scan copies bytes, as driver may reuse them
+	b := append([]byte{}, rawPoint...)
+	if assert.NoError(t, v.Scan(b)) {
+		b[5] = 0
+		assert.Equal(t, View(rawPoint), v)
+		assert.Equal(t, 1, v.NumPoints())
+		assert.Equal(t, Envelope{30, 10, 30, 10}, v.Envelope())
+	}
+
+	if v, err := NewView(rawEWKBPoint); assert.NoError(t, err) {
+		assert.Equal(t, Kind(GeomPoint), v.Kind())
+		x, y := v.Coords().XY(0)
+		assert.Equal(t, Point{30, 10}, Point{x, y})
+	}
+
+	if v, err := NewView(Marshal(LineStringZM{{1, 2, 3, 4}, {5, 6, 7, 8}})); assert.NoError(t, err) {
+		c := v.Coords()
+		assert.Equal(t, 4, c.NumOrdinates())
+		assert.Equal(t, []float64{5, 6, 7, 8}, c.Ordinates(nil, 1))
+		assert.Equal(t, 2, v.NumPoints())
+	}
+
+	if v, err := NewView(Marshal(GeometryCollection{EmptyPoint(), MultiPoint{}})); assert.NoError(t, err) {
+		assert.Equal(t, 0, v.NumPoints())
+		assert.Equal(t, 0, v.Dimension())
+		assert.True(t, v.IsEmpty())
+		assert.True(t, v.Envelope().IsEmpty())
+	}
+
+	cs := CircularString{{-1, 0}, {0, 1}, {1, 0}}
+	if v, err := NewView(Marshal(GeometryCollection{cs, Point{0, -2}})); assert.NoError(t, err) {
+		assert.Equal(t, 1, v.Dimension())
+		assert.InDeltaSlice(t, []float64{-1, -2, 1, 1}, envelopeSlice(v.Envelope()), 1e-12)
+	}
+
+	// members may have byte order different from their parent
+	mixed := append(Marshal(MultiPoint{}, WithByteOrder(BigEndian)), Marshal(Point{1, 2})...)
+	mixed = append(mixed, Marshal(Point{3, 4})...)
+	mixed[HeaderSize+CountSize-1] = 2
+	if v, err := NewView(mixed); assert.NoError(t, err) {
+		assert.Equal(t, 2, v.NumGeometries())
+		assert.Equal(t, 2, v.NumPoints())
+		assert.Equal(t, Envelope{1, 2, 3, 4}, v.Envelope())
+		assert.Equal(t, View(Marshal(Point{3, 4})), v.Geometry(1))
+		assert.True(t, v.Equal(MultiPoint{{1, 2}, {3, 4}}))
+	}
+
+	// malformed view returns zero values
+	malformed := View(rawMultiPolygon[:20])
+	assert.Nil(t, malformed.Geometry(1))
+	assert.Empty(t, malformed.Geometries())
+	assert.Nil(t, View(rawPoint).Geometries())
+	assert.Zero(t, View(nil).Kind())
+	assert.Zero(t, View(nil).NumPoints())
+	assert.True(t, View(nil).Envelope().IsEmpty())
+}
+
+func TestViewGeometry(t *testing.T) {
+	v, err := NewView(rawMultiLineString)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	buf := &bytes.Buffer{}
+	v.Write(buf)
+	assert.Equal(t, rawMultiLineString, buf.Bytes())
+	assert.Equal(t, rawMultiLineString, Marshal(v, WithByteOrder(BigEndian)))
+	assert.Equal(t, len(rawMultiLineString), v.ByteSize())
+
+	gc := GeometryCollection{v, Point{1, 2}}
+	if g, err := New(Marshal(gc)); assert.NoError(t, err) {
+		mls, _ := v.Decode()
+		assert.Equal(t, GeometryCollection{mls, Point{1, 2}}, g)
+	}
+}
@@ -0,0 +1,128 @@
+package wkb
+
+import (
+	"encoding/binary"
+)
+
+// walk traverses body of geometry of given kind starting at b[off:] and returns offset past its end.
+// Nested geometry headers are reported to entity before being read, allowing formats that replace
+// their byte order with a marker. Member with marker instead of byte order inherits one of its parent.
+func walk(b []byte, off int, dec binary.ByteOrder, k Kind, entity func(off int) error, coord func(x, y float64)) (int, error) {
+	return walkDepth(b, off, dec, k, entity, coord, 1)
+}
+
+// walkDepth bounds nesting of multi geometries and collections by DefaultLimits.
+func walkDepth(b []byte, off int, dec binary.ByteOrder, k Kind, entity func(off int) error, coord func(x, y float64), depth int) (int, error) {
+	if limit := DefaultLimits.MaxDepth; limit > 0 && depth > limit {
+		return 0, ErrLimitExceeded
+	}
+
+	size, ok := coordSize(k)
+	if !ok {
+		return 0, ErrUnsupportedValue
+	}
+
+	coords := func(off, n int) (int, error) {
+		if n < 0 || n > (len(b)-off)/size {
+			return 0, ErrInvalidStorage
+		}
+		if coord != nil {
+			for i := 0; i < n; i++ {
+				_, x := readFloat64(b[off+i*size:], dec)
+				_, y := readFloat64(b[off+i*size+Float64Size:], dec)
+				coord(x, y)
+			}
+		}
+		return off + n*size, nil
+	}
+
+	count := func(off int) (int, int, error) {
+		if len(b)-off < CountSize {
+			return 0, 0, ErrInvalidStorage
+		}
+		_, n := readCount(b[off:], dec)
+		return off + CountSize, n, nil
+	}
+
+	var n int
+	var err error
+	switch k % 1000 {
+	case GeomPoint:
+		return coords(off, 1)
+	case GeomLineString, GeomCircularString:
+		if off, n, err = count(off); err != nil {
+			return 0, err
+		}
+		return coords(off, n)
+	case GeomPolygon, GeomTriangle:
+		if off, n, err = count(off); err != nil {
+			return 0, err
+		}
+		for i := 0; i < n; i++ {
+			var m int
+			if off, m, err = count(off); err != nil {
+				return 0, err
+			}
+			if off, err = coords(off, m); err != nil {
+				return 0, err
+			}
+		}
+		return off, nil
+	case GeomMultiPoint, GeomMultiLineString, GeomMultiPolygon, GeomCollection,
+		GeomCompoundCurve, GeomCurvePolygon, GeomMultiCurve, GeomMultiSurface, GeomPolyhedralSurface, GeomTIN:
+		if off, n, err = count(off); err != nil {
+			return 0, err
+		}
+		for i := 0; i < n; i++ {
+			if len(b)-off < HeaderSize {
+				return 0, ErrInvalidStorage
+			}
+			order := byteOrder(b[off])
+			if entity != nil {
+				if err = entity(off); err != nil {
+					return 0, err
+				}
+			}
+			if order == nil {
+				if entity == nil {
+					return 0, ErrInvalidStorage
+				}
+				order = dec
+			}
+			_, code := readUint32(b[off+ByteOrderSize:], order)
+			if off, err = walkDepth(b, off+HeaderSize, order, kind(code), entity, coord, depth+1); err != nil {
+				return 0, err
+			}
+		}
+		return off, nil
+	default:
+		return 0, ErrUnsupportedValue
+	}
+}
+
+func coordSize(k Kind) (int, bool) {
+	switch k / 1000 {
+	case 0:
+		return PointSize, true
+	case 1:
+		return PointZSize, true
+	case 2:
+		return PointMSize, true
+	case 3:
+		return PointZMSize, true
+	default:
+		return 0, false
+	}
+}
+
+// dimSize returns number of ordinates for dimension encoded in thousands of ISO type code.
+func dimSize(dim int) int {
+	switch dim {
+	case 1, 2:
+		return 3
+	case 3:
+		return 4
+	default:
+		return 2
+	}
+}
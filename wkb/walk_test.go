@@ -0,0 +1,41 @@
+package wkb
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalk(t *testing.T) {
+	invalid := []struct {
+		err error
+		b   []byte
+	}{
+		{ErrInvalidStorage, rawPolygon[:len(rawPolygon)-1]},
+		{ErrInvalidStorage, rawMultiPolygon[:HeaderSize+CountSize+2]},
+		{ErrUnsupportedValue, []byte{0x01, 0x42, 0x00, 0x00, 0x00}},
+		{ErrInvalidStorage, append(append([]byte{}, rawMultiPoint[:HeaderSize+CountSize]...), 0x02, 0x01, 0x00, 0x00, 0x00)},
+	}
+
+	for _, e := range invalid {
+		_, code := readUint32(e.b[ByteOrderSize:], binary.LittleEndian)
+		if _, err := walk(e.b, HeaderSize, binary.LittleEndian, kind(code), nil, nil); assert.Error(t, err) {
+			assert.ErrorIs(t, err, e.err)
+		}
+	}
+
+	entities := []int{}
+	coords := []Point{}
+	n, err := walk(rawGeometryCollection, HeaderSize, binary.LittleEndian, GeomCollection, func(off int) error {
+		entities = append(entities, off)
+		return nil
+	}, func(x, y float64) {
+		coords = append(coords, Point{x, y})
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, len(rawGeometryCollection), n)
+		assert.Equal(t, []int{9, 30}, entities)
+		assert.Equal(t, []Point{{4, 6}, {4, 6}, {7, 10}}, coords)
+	}
+}
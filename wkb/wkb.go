@@ -3,6 +3,7 @@ package wkb
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"unsafe"
 )
 
@@ -24,6 +25,112 @@ const (
 	GeomMultiLineString
 	GeomMultiPolygon
 	GeomCollection
+	GeomCircularString
+	GeomCompoundCurve
+	GeomCurvePolygon
+	GeomMultiCurve
+	GeomMultiSurface
+	GeomCurve
+	GeomSurface
+	GeomPolyhedralSurface
+	GeomTIN
+	GeomTriangle
+)
+
+const (
+	GeomPointZ = iota + 1001
+	GeomLineStringZ
+	GeomPolygonZ
+	GeomMultiPointZ
+	GeomMultiLineStringZ
+	GeomMultiPolygonZ
+	GeomCollectionZ
+	GeomCircularStringZ
+	GeomCompoundCurveZ
+	GeomCurvePolygonZ
+	GeomMultiCurveZ
+	GeomMultiSurfaceZ
+	GeomCurveZ
+	GeomSurfaceZ
+	GeomPolyhedralSurfaceZ
+	GeomTINZ
+	GeomTriangleZ
+)
+
+const (
+	GeomPointM = iota + 2001
+	GeomLineStringM
+	GeomPolygonM
+	GeomMultiPointM
+	GeomMultiLineStringM
+	GeomMultiPolygonM
+	GeomCollectionM
+	GeomCircularStringM
+	GeomCompoundCurveM
+	GeomCurvePolygonM
+	GeomMultiCurveM
+	GeomMultiSurfaceM
+	GeomCurveM
+	GeomSurfaceM
+	GeomPolyhedralSurfaceM
+	GeomTINM
+	GeomTriangleM
+)
+
+const (
+	GeomPointZM = iota + 3001
+	GeomLineStringZM
+	GeomPolygonZM
+	GeomMultiPointZM
+	GeomMultiLineStringZM
+	GeomMultiPolygonZM
+	GeomCollectionZM
+	GeomCircularStringZM
+	GeomCompoundCurveZM
+	GeomCurvePolygonZM
+	GeomMultiCurveZM
+	GeomMultiSurfaceZM
+	GeomCurveZM
+	GeomSurfaceZM
+	GeomPolyhedralSurfaceZM
+	GeomTINZM
+	GeomTriangleZM
+)
+
+var kindNames = map[Kind]string{
+	GeomPoint:             "Point",
+	GeomLineString:        "LineString",
+	GeomPolygon:           "Polygon",
+	GeomMultiPoint:        "MultiPoint",
+	GeomMultiLineString:   "MultiLineString",
+	GeomMultiPolygon:      "MultiPolygon",
+	GeomCollection:        "GeometryCollection",
+	GeomCircularString:    "CircularString",
+	GeomCompoundCurve:     "CompoundCurve",
+	GeomCurvePolygon:      "CurvePolygon",
+	GeomMultiCurve:        "MultiCurve",
+	GeomMultiSurface:      "MultiSurface",
+	GeomCurve:             "Curve",
+	GeomSurface:           "Surface",
+	GeomPolyhedralSurface: "PolyhedralSurface",
+	GeomTIN:               "TIN",
+	GeomTriangle:          "Triangle",
+}
+
+var dimNames = []string{"", "Z", "M", "ZM"}
+
+// String returns name of kind, such as "PolygonZ".
+func (k Kind) String() string {
+	if name, ok := kindNames[k%1000]; ok && k/1000 < 4 {
+		return name + dimNames[k/1000]
+	}
+	return fmt.Sprintf("Kind(%d)", uint32(k))
+}
+
+const (
+	ewkbZ    = 0x80000000
+	ewkbM    = 0x40000000
+	ewkbSRID = 0x20000000
 )
 
 const (
@@ -31,18 +138,32 @@ const (
 	GeomTypeSize  = int(unsafe.Sizeof(Kind(0)))
 	HeaderSize    = ByteOrderSize + GeomTypeSize
 	CountSize     = int(unsafe.Sizeof(uint32(0)))
+	SRIDSize      = int(unsafe.Sizeof(int32(0)))
 	Float64Size   = int(unsafe.Sizeof(float64(0)))
 	PointSize     = int(unsafe.Sizeof(Point{}))
+	PointZSize    = int(unsafe.Sizeof(PointZ{}))
+	PointMSize    = int(unsafe.Sizeof(PointM{}))
+	PointZMSize   = int(unsafe.Sizeof(PointZM{}))
 )
 
 var (
 	ErrInvalidStorage   = errors.New("Invalid storage type or size")
 	ErrUnsupportedValue = errors.New("Unsupported value")
+	ErrLimitExceeded    = errors.New("Decoding limit exceeded")
 )
 
 type Geometry interface {
 	ByteSize() int
 	Write(*bytes.Buffer)
+	Kind() Kind
+	// Dimension returns topological dimension, 0 for points, 1 for curves and 2 for surfaces.
+	Dimension() int
+	Envelope() Envelope
+	// NumPoints returns number of points, including those of rings and members, empty points are not counted.
+	NumPoints() int
+	IsEmpty() bool
+	// Equal reports whether other is geometry of same kind with equal coordinates in same order.
+	Equal(other Geometry) bool
 }
 
 type LineString Points
@@ -51,6 +172,68 @@ type MultiPoint Points
 type MultiLineString []LineString
 type MultiPolygon []Polygon
 type GeometryCollection []Geometry
+type CircularString Points
+type CompoundCurve []Geometry
+type CurvePolygon []Geometry
+type MultiCurve []Geometry
+type MultiSurface []Geometry
+type PolyhedralSurface []Polygon
+type TIN []Triangle
+type Triangle []LinearRing
 
 type LinearRing Points
 type Points []Point
+
+type LineStringZ PointsZ
+type PolygonZ []LinearRingZ
+type MultiPointZ PointsZ
+type MultiLineStringZ []LineStringZ
+type MultiPolygonZ []PolygonZ
+type GeometryCollectionZ []Geometry
+type CircularStringZ PointsZ
+type CompoundCurveZ []Geometry
+type CurvePolygonZ []Geometry
+type MultiCurveZ []Geometry
+type MultiSurfaceZ []Geometry
+type PolyhedralSurfaceZ []PolygonZ
+type TINZ []TriangleZ
+type TriangleZ []LinearRingZ
+
+type LinearRingZ PointsZ
+type PointsZ []PointZ
+
+type LineStringM PointsM
+type PolygonM []LinearRingM
+type MultiPointM PointsM
+type MultiLineStringM []LineStringM
+type MultiPolygonM []PolygonM
+type GeometryCollectionM []Geometry
+type CircularStringM PointsM
+type CompoundCurveM []Geometry
+type CurvePolygonM []Geometry
+type MultiCurveM []Geometry
+type MultiSurfaceM []Geometry
+type PolyhedralSurfaceM []PolygonM
+type TINM []TriangleM
+type TriangleM []LinearRingM
+
+type LinearRingM PointsM
+type PointsM []PointM
+
+type LineStringZM PointsZM
+type PolygonZM []LinearRingZM
+type MultiPointZM PointsZM
+type MultiLineStringZM []LineStringZM
+type MultiPolygonZM []PolygonZM
+type GeometryCollectionZM []Geometry
+type CircularStringZM PointsZM
+type CompoundCurveZM []Geometry
+type CurvePolygonZM []Geometry
+type MultiCurveZM []Geometry
+type MultiSurfaceZM []Geometry
+type PolyhedralSurfaceZM []PolygonZM
+type TINZM []TriangleZM
+type TriangleZM []LinearRingZM
+
+type LinearRingZM PointsZM
+type PointsZM []PointZM
@@ -0,0 +1,1306 @@
+package wkb
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidWKT = errors.New("Invalid WKT")
+
+var wktTags = map[Kind]string{
+	GeomPoint:             "POINT",
+	GeomLineString:        "LINESTRING",
+	GeomPolygon:           "POLYGON",
+	GeomMultiPoint:        "MULTIPOINT",
+	GeomMultiLineString:   "MULTILINESTRING",
+	GeomMultiPolygon:      "MULTIPOLYGON",
+	GeomCollection:        "GEOMETRYCOLLECTION",
+	GeomCircularString:    "CIRCULARSTRING",
+	GeomCompoundCurve:     "COMPOUNDCURVE",
+	GeomCurvePolygon:      "CURVEPOLYGON",
+	GeomMultiCurve:        "MULTICURVE",
+	GeomMultiSurface:      "MULTISURFACE",
+	GeomPolyhedralSurface: "POLYHEDRALSURFACE",
+	GeomTIN:               "TIN",
+	GeomTriangle:          "TRIANGLE",
+}
+
+var wktDims = []string{"", "Z", "M", "ZM"}
+
+// ParseWKT parses geometry in well-known text format.
+// Dimension is inferred from coordinates when not specified, and empty points are represented with NaN coordinates.
+func ParseWKT(s string) (Geometry, error) {
+	b, err := wktToWKB(s)
+	if err != nil {
+		return nil, err
+	}
+
+	_, g, err := ReadGeometry(b)
+	return g, err
+}
+
+func wktToWKB(s string) ([]byte, error) {
+	p := wktParser{s: s, enc: newEncoder(nil)}
+	if err := p.geometry(1, -1); err != nil {
+		return nil, err
+	}
+
+	if tok := p.next(); tok != "" {
+		return nil, ErrInvalidWKT
+	}
+
+	return p.enc.buf, nil
+}
+
+type wktParser struct {
+	s   string
+	pos int
+	enc *encoder
+}
+
+func (p *wktParser) peek() string {
+	for p.pos < len(p.s) && isSpace(p.s[p.pos]) {
+		p.pos++
+	}
+
+	if p.pos == len(p.s) {
+		return ""
+	}
+
+	end := p.pos + 1
+	switch c := p.s[p.pos]; {
+	case c == '(' || c == ')' || c == ',':
+	case isLetter(c):
+		for end < len(p.s) && isLetter(p.s[end]) {
+			end++
+		}
+	default:
+		for end < len(p.s) && !isSpace(p.s[end]) && !strings.ContainsRune("(),", rune(p.s[end])) {
+			end++
+		}
+	}
+	return p.s[p.pos:end]
+}
+
+func (p *wktParser) next() string {
+	tok := p.peek()
+	p.pos += len(tok)
+	return tok
+}
+
+func (p *wktParser) expect(tok string) error {
+	if p.next() != tok {
+		return ErrInvalidWKT
+	}
+	return nil
+}
+
+// geometry parses tagged geometry at given nesting depth and writes it as WKB.
+// Dimension not specified by tag is inherited from parent, or inferred from first coordinate ahead
+// so that empty members preceding it are written with the same dimension.
+func (p *wktParser) geometry(depth, parent int) error {
+	if limit := DefaultLimits.MaxDepth; limit > 0 && depth > limit {
+		return ErrLimitExceeded
+	}
+
+	k, dim := wktTag(strings.ToUpper(p.next()))
+	if k == 0 {
+		return ErrInvalidWKT
+	}
+
+	if dim < 0 {
+		if d := wktDim(strings.ToUpper(p.peek())); d > 0 {
+			p.next()
+			dim = d
+		}
+	}
+	if dim < 0 {
+		dim = parent
+	}
+	if dim < 0 {
+		dim = p.scanDim()
+	}
+
+	p.enc.header(k + Kind(dim*1000))
+
+	if strings.ToUpper(p.peek()) == "EMPTY" {
+		p.next()
+		if k == GeomPoint {
+			p.nan(dim)
+		} else {
+			p.enc.count(0)
+		}
+		return nil
+	}
+
+	if err := p.expect("("); err != nil {
+		return err
+	}
+
+	var err error
+	switch k {
+	case GeomPoint:
+		err = p.coord(dim)
+	case GeomLineString, GeomCircularString:
+		err = p.coords(dim)
+	case GeomPolygon, GeomTriangle:
+		err = p.list(func() error {
+			return p.ring(dim)
+		})
+	case GeomMultiPoint:
+		err = p.list(func() error {
+			return p.element(GeomPoint, dim, func() error {
+				if strings.ToUpper(p.peek()) == "EMPTY" {
+					p.next()
+					p.nan(dim)
+					return nil
+				}
+				if p.peek() != "(" {
+					return p.coord(dim)
+				}
+				p.next()
+				if err := p.coord(dim); err != nil {
+					return err
+				}
+				return p.expect(")")
+			})
+		})
+	case GeomMultiLineString:
+		err = p.list(func() error {
+			return p.element(GeomLineString, dim, func() error {
+				return p.ring(dim)
+			})
+		})
+	case GeomMultiPolygon, GeomPolyhedralSurface:
+		err = p.list(func() error {
+			return p.element(GeomPolygon, dim, func() error {
+				return p.polygon(dim)
+			})
+		})
+	case GeomTIN:
+		err = p.list(func() error {
+			return p.element(GeomTriangle, dim, func() error {
+				return p.polygon(dim)
+			})
+		})
+	case GeomCompoundCurve, GeomCurvePolygon, GeomMultiCurve:
+		err = p.list(func() error {
+			return p.member(depth, dim, GeomLineString, func() error {
+				return p.ring(dim)
+			})
+		})
+	case GeomMultiSurface:
+		err = p.list(func() error {
+			return p.member(depth, dim, GeomPolygon, func() error {
+				return p.polygon(dim)
+			})
+		})
+	case GeomCollection:
+		err = p.list(func() error {
+			return p.geometry(depth+1, dim)
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	return p.expect(")")
+}
+
+// scanDim looks ahead for first coordinate or dimension of nested tag to infer dimension of geometry
+// whose body follows, 0 when there is none.
+func (p *wktParser) scanDim() int {
+	pos := p.pos
+	defer func() {
+		p.pos = pos
+	}()
+
+	level := 0
+	for {
+		tok := strings.ToUpper(p.next())
+		switch {
+		case tok == "":
+			return 0
+		case tok == "(":
+			level++
+		case tok == ")" || tok == ",":
+			if tok == ")" {
+				level--
+			}
+			if level <= 0 {
+				return 0
+			}
+		case isNumber(tok):
+			n := 1
+			for tok := p.peek(); tok != "" && !strings.Contains("(),", tok); tok = p.peek() {
+				p.next()
+				n++
+			}
+			switch n {
+			case 3:
+				return 1
+			case 4:
+				return 3
+			default:
+				return 0
+			}
+		default:
+			if _, d := wktTag(tok); d > 0 {
+				return d
+			}
+			if d := wktDim(tok); d > 0 {
+				return d
+			}
+		}
+	}
+}
+
+// list parses comma separated elements and writes their count followed by their content.
+func (p *wktParser) list(element func() error) error {
+	off := len(p.enc.buf)
+	p.enc.count(0)
+
+	n := 0
+	for {
+		if err := element(); err != nil {
+			return err
+		}
+		n++
+
+		if p.peek() != "," {
+			break
+		}
+		p.next()
+	}
+
+	p.enc.order.PutUint32(p.enc.buf[off:], uint32(n))
+	return nil
+}
+
+// element writes nested geometry of multi geometry with the same dimension as parent.
+func (p *wktParser) element(k Kind, dim int, body func() error) error {
+	p.enc.header(k + Kind(dim*1000))
+	return body()
+}
+
+// member parses member of curve or surface made of other geometries, which has given kind when untagged.
+func (p *wktParser) member(depth, dim int, untagged Kind, body func() error) error {
+	if tok := strings.ToUpper(p.peek()); tok == "(" || tok == "EMPTY" {
+		return p.element(untagged, dim, body)
+	}
+	return p.geometry(depth+1, dim)
+}
+
+func (p *wktParser) polygon(dim int) error {
+	if strings.ToUpper(p.peek()) == "EMPTY" {
+		p.next()
+		p.enc.count(0)
+		return nil
+	}
+
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	if err := p.list(func() error { return p.ring(dim) }); err != nil {
+		return err
+	}
+	return p.expect(")")
+}
+
+func (p *wktParser) ring(dim int) error {
+	if strings.ToUpper(p.peek()) == "EMPTY" {
+		p.next()
+		p.enc.count(0)
+		return nil
+	}
+
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	if err := p.coords(dim); err != nil {
+		return err
+	}
+	return p.expect(")")
+}
+
+func (p *wktParser) coords(dim int) error {
+	return p.list(func() error {
+		return p.coord(dim)
+	})
+}
+
+// coord parses single coordinate with number of ordinates matching dimension.
+func (p *wktParser) coord(dim int) error {
+	n := 0
+	for {
+		tok := p.peek()
+		if tok == "" || tok == "," || tok == ")" {
+			break
+		}
+
+		f, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return ErrInvalidWKT
+		}
+		p.next()
+		p.enc.float64(f)
+		n++
+	}
+
+	if n != dimSize(dim) {
+		return ErrInvalidWKT
+	}
+	return nil
+}
+
+func (p *wktParser) nan(dim int) {
+	for i := 0; i < dimSize(dim); i++ {
+		p.enc.float64(math.NaN())
+	}
+}
+
+// wktTag returns kind of tag and dimension of its suffix, -1 when it has none.
+func wktTag(tag string) (Kind, int) {
+	for d := len(wktDims) - 1; d > 0; d-- {
+		if base := strings.TrimSuffix(tag, wktDims[d]); base != tag && wktKind(base) != 0 {
+			return wktKind(base), d
+		}
+	}
+	return wktKind(tag), -1
+}
+
+func wktKind(tag string) Kind {
+	for k, t := range wktTags {
+		if t == tag {
+			return k
+		}
+	}
+	return 0
+}
+
+func wktDim(tok string) int {
+	for d := 1; d < len(wktDims); d++ {
+		if wktDims[d] == tok {
+			return d
+		}
+	}
+	return 0
+}
+
+func isNumber(tok string) bool {
+	_, err := strconv.ParseFloat(tok, 64)
+	return err == nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// wkt formats geometry by encoding it to WKB and rendering the encoded form.
+func wkt(g Geometry) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, g.ByteSize()))
+	g.Write(buf)
+
+	out := &bytes.Buffer{}
+	if _, err := formatWKT(out, buf.Bytes(), 0); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// formatWKT renders WKB geometry, omitting tag when it is of untagged kind.
+func formatWKT(out *bytes.Buffer, b []byte, untagged Kind) ([]byte, error) {
+	if len(b) < HeaderSize {
+		return nil, ErrInvalidStorage
+	}
+
+	dec := byteOrder(b[0])
+	if dec == nil {
+		return nil, ErrInvalidStorage
+	}
+
+	b, code := readUint32(b[ByteOrderSize:], dec)
+	k := kind(code)
+	size, ok := coordSize(k)
+	tag, known := wktTags[k%1000]
+	if !ok || !known {
+		return nil, ErrUnsupportedValue
+	}
+
+	if k%1000 != untagged {
+		out.WriteString(tag)
+		out.WriteByte(' ')
+		if dim := wktDims[k/1000]; dim != "" {
+			out.WriteString(dim)
+			out.WriteByte(' ')
+		}
+	}
+
+	coord := func(b []byte) []byte {
+		scratch := [32]byte{}
+		for i := 0; i < size/Float64Size; i++ {
+			var f float64
+			if i > 0 {
+				out.WriteByte(' ')
+			}
+			b, f = readFloat64(b, dec)
+			out.Write(strconv.AppendFloat(scratch[:0], f, 'f', -1, 64))
+		}
+		return b
+	}
+
+	count := func(b []byte) ([]byte, int, error) {
+		if len(b) < CountSize {
+			return nil, 0, ErrInvalidStorage
+		}
+		b, n := readCount(b, dec)
+		if n == 0 {
+			out.WriteString("EMPTY")
+		}
+		return b, n, nil
+	}
+
+	coords := func(b []byte, n int) ([]byte, error) {
+		if n > len(b)/size {
+			return nil, ErrInvalidStorage
+		}
+		out.WriteByte('(')
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			b = coord(b)
+		}
+		out.WriteByte(')')
+		return b, nil
+	}
+
+	var n int
+	var err error
+	switch k % 1000 {
+	case GeomPoint:
+		if len(b) < size {
+			return nil, ErrInvalidStorage
+		}
+		if isNaN(b[:size], dec) {
+			out.WriteString("EMPTY")
+			return b[size:], nil
+		}
+		return coords(b, 1)
+	case GeomLineString, GeomCircularString:
+		if b, n, err = count(b); err != nil || n == 0 {
+			return b, err
+		}
+		return coords(b, n)
+	case GeomPolygon, GeomTriangle:
+		if b, n, err = count(b); err != nil || n == 0 {
+			return b, err
+		}
+		out.WriteByte('(')
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			var m int
+			if b, m, err = count(b); err != nil {
+				return nil, err
+			}
+			if m == 0 {
+				continue
+			}
+			if b, err = coords(b, m); err != nil {
+				return nil, err
+			}
+		}
+		out.WriteByte(')')
+		return b, nil
+	default:
+		if b, n, err = count(b); err != nil || n == 0 {
+			return b, err
+		}
+		untagged := wktUntagged(k % 1000)
+		out.WriteByte('(')
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			if b, err = formatWKT(out, b, untagged); err != nil {
+				return nil, err
+			}
+		}
+		out.WriteByte(')')
+		return b, nil
+	}
+}
+
+// wktUntagged returns kind of members which are written without tag, 0 when all members are tagged.
+func wktUntagged(k Kind) Kind {
+	switch k {
+	case GeomMultiPoint:
+		return GeomPoint
+	case GeomMultiLineString, GeomCompoundCurve, GeomCurvePolygon, GeomMultiCurve:
+		return GeomLineString
+	case GeomMultiPolygon, GeomMultiSurface, GeomPolyhedralSurface:
+		return GeomPolygon
+	case GeomTIN:
+		return GeomTriangle
+	default:
+		return 0
+	}
+}
+
+func isNaN(b []byte, dec binary.ByteOrder) bool {
+	for len(b) > 0 {
+		var f float64
+		b, f = readFloat64(b, dec)
+		if !math.IsNaN(f) {
+			return false
+		}
+	}
+	return true
+}
+
+func wktString(g Geometry) string {
+	b, err := wkt(g)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func unmarshalWKT(text []byte, dst sql.Scanner) error {
+	b, err := wktToWKB(string(text))
+	if err != nil {
+		return err
+	}
+	return dst.Scan(b)
+}
+
+func (p Point) String() string {
+	return wktString(p)
+}
+
+func (p Point) MarshalText() ([]byte, error) {
+	return wkt(p)
+}
+
+func (p *Point) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, p)
+}
+
+func (ls LineString) String() string {
+	return wktString(ls)
+}
+
+func (ls LineString) MarshalText() ([]byte, error) {
+	return wkt(ls)
+}
+
+func (ls *LineString) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ls)
+}
+
+func (p Polygon) String() string {
+	return wktString(p)
+}
+
+func (p Polygon) MarshalText() ([]byte, error) {
+	return wkt(p)
+}
+
+func (p *Polygon) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, p)
+}
+
+func (mp MultiPoint) String() string {
+	return wktString(mp)
+}
+
+func (mp MultiPoint) MarshalText() ([]byte, error) {
+	return wkt(mp)
+}
+
+func (mp *MultiPoint) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mp)
+}
+
+func (mls MultiLineString) String() string {
+	return wktString(mls)
+}
+
+func (mls MultiLineString) MarshalText() ([]byte, error) {
+	return wkt(mls)
+}
+
+func (mls *MultiLineString) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mls)
+}
+
+func (mp MultiPolygon) String() string {
+	return wktString(mp)
+}
+
+func (mp MultiPolygon) MarshalText() ([]byte, error) {
+	return wkt(mp)
+}
+
+func (mp *MultiPolygon) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mp)
+}
+
+func (gc GeometryCollection) String() string {
+	return wktString(gc)
+}
+
+func (gc GeometryCollection) MarshalText() ([]byte, error) {
+	return wkt(gc)
+}
+
+func (gc *GeometryCollection) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, gc)
+}
+
+func (p PointZ) String() string {
+	return wktString(p)
+}
+
+func (p PointZ) MarshalText() ([]byte, error) {
+	return wkt(p)
+}
+
+func (p *PointZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, p)
+}
+
+func (ls LineStringZ) String() string {
+	return wktString(ls)
+}
+
+func (ls LineStringZ) MarshalText() ([]byte, error) {
+	return wkt(ls)
+}
+
+func (ls *LineStringZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ls)
+}
+
+func (p PolygonZ) String() string {
+	return wktString(p)
+}
+
+func (p PolygonZ) MarshalText() ([]byte, error) {
+	return wkt(p)
+}
+
+func (p *PolygonZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, p)
+}
+
+func (mp MultiPointZ) String() string {
+	return wktString(mp)
+}
+
+func (mp MultiPointZ) MarshalText() ([]byte, error) {
+	return wkt(mp)
+}
+
+func (mp *MultiPointZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mp)
+}
+
+func (mls MultiLineStringZ) String() string {
+	return wktString(mls)
+}
+
+func (mls MultiLineStringZ) MarshalText() ([]byte, error) {
+	return wkt(mls)
+}
+
+func (mls *MultiLineStringZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mls)
+}
+
+func (mp MultiPolygonZ) String() string {
+	return wktString(mp)
+}
+
+func (mp MultiPolygonZ) MarshalText() ([]byte, error) {
+	return wkt(mp)
+}
+
+func (mp *MultiPolygonZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mp)
+}
+
+func (gc GeometryCollectionZ) String() string {
+	return wktString(gc)
+}
+
+func (gc GeometryCollectionZ) MarshalText() ([]byte, error) {
+	return wkt(gc)
+}
+
+func (gc *GeometryCollectionZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, gc)
+}
+
+func (p PointM) String() string {
+	return wktString(p)
+}
+
+func (p PointM) MarshalText() ([]byte, error) {
+	return wkt(p)
+}
+
+func (p *PointM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, p)
+}
+
+func (ls LineStringM) String() string {
+	return wktString(ls)
+}
+
+func (ls LineStringM) MarshalText() ([]byte, error) {
+	return wkt(ls)
+}
+
+func (ls *LineStringM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ls)
+}
+
+func (p PolygonM) String() string {
+	return wktString(p)
+}
+
+func (p PolygonM) MarshalText() ([]byte, error) {
+	return wkt(p)
+}
+
+func (p *PolygonM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, p)
+}
+
+func (mp MultiPointM) String() string {
+	return wktString(mp)
+}
+
+func (mp MultiPointM) MarshalText() ([]byte, error) {
+	return wkt(mp)
+}
+
+func (mp *MultiPointM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mp)
+}
+
+func (mls MultiLineStringM) String() string {
+	return wktString(mls)
+}
+
+func (mls MultiLineStringM) MarshalText() ([]byte, error) {
+	return wkt(mls)
+}
+
+func (mls *MultiLineStringM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mls)
+}
+
+func (mp MultiPolygonM) String() string {
+	return wktString(mp)
+}
+
+func (mp MultiPolygonM) MarshalText() ([]byte, error) {
+	return wkt(mp)
+}
+
+func (mp *MultiPolygonM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mp)
+}
+
+func (gc GeometryCollectionM) String() string {
+	return wktString(gc)
+}
+
+func (gc GeometryCollectionM) MarshalText() ([]byte, error) {
+	return wkt(gc)
+}
+
+func (gc *GeometryCollectionM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, gc)
+}
+
+func (p PointZM) String() string {
+	return wktString(p)
+}
+
+func (p PointZM) MarshalText() ([]byte, error) {
+	return wkt(p)
+}
+
+func (p *PointZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, p)
+}
+
+func (ls LineStringZM) String() string {
+	return wktString(ls)
+}
+
+func (ls LineStringZM) MarshalText() ([]byte, error) {
+	return wkt(ls)
+}
+
+func (ls *LineStringZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ls)
+}
+
+func (p PolygonZM) String() string {
+	return wktString(p)
+}
+
+func (p PolygonZM) MarshalText() ([]byte, error) {
+	return wkt(p)
+}
+
+func (p *PolygonZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, p)
+}
+
+func (mp MultiPointZM) String() string {
+	return wktString(mp)
+}
+
+func (mp MultiPointZM) MarshalText() ([]byte, error) {
+	return wkt(mp)
+}
+
+func (mp *MultiPointZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mp)
+}
+
+func (mls MultiLineStringZM) String() string {
+	return wktString(mls)
+}
+
+func (mls MultiLineStringZM) MarshalText() ([]byte, error) {
+	return wkt(mls)
+}
+
+func (mls *MultiLineStringZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mls)
+}
+
+func (mp MultiPolygonZM) String() string {
+	return wktString(mp)
+}
+
+func (mp MultiPolygonZM) MarshalText() ([]byte, error) {
+	return wkt(mp)
+}
+
+func (mp *MultiPolygonZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mp)
+}
+
+func (gc GeometryCollectionZM) String() string {
+	return wktString(gc)
+}
+
+func (gc GeometryCollectionZM) MarshalText() ([]byte, error) {
+	return wkt(gc)
+}
+
+func (gc *GeometryCollectionZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, gc)
+}
+
+func (cs CircularString) String() string {
+	return wktString(cs)
+}
+
+func (cs CircularString) MarshalText() ([]byte, error) {
+	return wkt(cs)
+}
+
+func (cs *CircularString) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cs)
+}
+
+func (cc CompoundCurve) String() string {
+	return wktString(cc)
+}
+
+func (cc CompoundCurve) MarshalText() ([]byte, error) {
+	return wkt(cc)
+}
+
+func (cc *CompoundCurve) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cc)
+}
+
+func (cp CurvePolygon) String() string {
+	return wktString(cp)
+}
+
+func (cp CurvePolygon) MarshalText() ([]byte, error) {
+	return wkt(cp)
+}
+
+func (cp *CurvePolygon) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cp)
+}
+
+func (mc MultiCurve) String() string {
+	return wktString(mc)
+}
+
+func (mc MultiCurve) MarshalText() ([]byte, error) {
+	return wkt(mc)
+}
+
+func (mc *MultiCurve) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mc)
+}
+
+func (ms MultiSurface) String() string {
+	return wktString(ms)
+}
+
+func (ms MultiSurface) MarshalText() ([]byte, error) {
+	return wkt(ms)
+}
+
+func (ms *MultiSurface) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ms)
+}
+
+func (ps PolyhedralSurface) String() string {
+	return wktString(ps)
+}
+
+func (ps PolyhedralSurface) MarshalText() ([]byte, error) {
+	return wkt(ps)
+}
+
+func (ps *PolyhedralSurface) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ps)
+}
+
+func (tin TIN) String() string {
+	return wktString(tin)
+}
+
+func (tin TIN) MarshalText() ([]byte, error) {
+	return wkt(tin)
+}
+
+func (tin *TIN) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, tin)
+}
+
+func (t Triangle) String() string {
+	return wktString(t)
+}
+
+func (t Triangle) MarshalText() ([]byte, error) {
+	return wkt(t)
+}
+
+func (t *Triangle) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, t)
+}
+
+func (cs CircularStringZ) String() string {
+	return wktString(cs)
+}
+
+func (cs CircularStringZ) MarshalText() ([]byte, error) {
+	return wkt(cs)
+}
+
+func (cs *CircularStringZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cs)
+}
+
+func (cc CompoundCurveZ) String() string {
+	return wktString(cc)
+}
+
+func (cc CompoundCurveZ) MarshalText() ([]byte, error) {
+	return wkt(cc)
+}
+
+func (cc *CompoundCurveZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cc)
+}
+
+func (cp CurvePolygonZ) String() string {
+	return wktString(cp)
+}
+
+func (cp CurvePolygonZ) MarshalText() ([]byte, error) {
+	return wkt(cp)
+}
+
+func (cp *CurvePolygonZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cp)
+}
+
+func (mc MultiCurveZ) String() string {
+	return wktString(mc)
+}
+
+func (mc MultiCurveZ) MarshalText() ([]byte, error) {
+	return wkt(mc)
+}
+
+func (mc *MultiCurveZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mc)
+}
+
+func (ms MultiSurfaceZ) String() string {
+	return wktString(ms)
+}
+
+func (ms MultiSurfaceZ) MarshalText() ([]byte, error) {
+	return wkt(ms)
+}
+
+func (ms *MultiSurfaceZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ms)
+}
+
+func (ps PolyhedralSurfaceZ) String() string {
+	return wktString(ps)
+}
+
+func (ps PolyhedralSurfaceZ) MarshalText() ([]byte, error) {
+	return wkt(ps)
+}
+
+func (ps *PolyhedralSurfaceZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ps)
+}
+
+func (tin TINZ) String() string {
+	return wktString(tin)
+}
+
+func (tin TINZ) MarshalText() ([]byte, error) {
+	return wkt(tin)
+}
+
+func (tin *TINZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, tin)
+}
+
+func (t TriangleZ) String() string {
+	return wktString(t)
+}
+
+func (t TriangleZ) MarshalText() ([]byte, error) {
+	return wkt(t)
+}
+
+func (t *TriangleZ) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, t)
+}
+
+func (cs CircularStringM) String() string {
+	return wktString(cs)
+}
+
+func (cs CircularStringM) MarshalText() ([]byte, error) {
+	return wkt(cs)
+}
+
+func (cs *CircularStringM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cs)
+}
+
+func (cc CompoundCurveM) String() string {
+	return wktString(cc)
+}
+
+func (cc CompoundCurveM) MarshalText() ([]byte, error) {
+	return wkt(cc)
+}
+
+func (cc *CompoundCurveM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cc)
+}
+
+func (cp CurvePolygonM) String() string {
+	return wktString(cp)
+}
+
+func (cp CurvePolygonM) MarshalText() ([]byte, error) {
+	return wkt(cp)
+}
+
+func (cp *CurvePolygonM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cp)
+}
+
+func (mc MultiCurveM) String() string {
+	return wktString(mc)
+}
+
+func (mc MultiCurveM) MarshalText() ([]byte, error) {
+	return wkt(mc)
+}
+
+func (mc *MultiCurveM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mc)
+}
+
+func (ms MultiSurfaceM) String() string {
+	return wktString(ms)
+}
+
+func (ms MultiSurfaceM) MarshalText() ([]byte, error) {
+	return wkt(ms)
+}
+
+func (ms *MultiSurfaceM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ms)
+}
+
+func (ps PolyhedralSurfaceM) String() string {
+	return wktString(ps)
+}
+
+func (ps PolyhedralSurfaceM) MarshalText() ([]byte, error) {
+	return wkt(ps)
+}
+
+func (ps *PolyhedralSurfaceM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ps)
+}
+
+func (tin TINM) String() string {
+	return wktString(tin)
+}
+
+func (tin TINM) MarshalText() ([]byte, error) {
+	return wkt(tin)
+}
+
+func (tin *TINM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, tin)
+}
+
+func (t TriangleM) String() string {
+	return wktString(t)
+}
+
+func (t TriangleM) MarshalText() ([]byte, error) {
+	return wkt(t)
+}
+
+func (t *TriangleM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, t)
+}
+
+func (cs CircularStringZM) String() string {
+	return wktString(cs)
+}
+
+func (cs CircularStringZM) MarshalText() ([]byte, error) {
+	return wkt(cs)
+}
+
+func (cs *CircularStringZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cs)
+}
+
+func (cc CompoundCurveZM) String() string {
+	return wktString(cc)
+}
+
+func (cc CompoundCurveZM) MarshalText() ([]byte, error) {
+	return wkt(cc)
+}
+
+func (cc *CompoundCurveZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cc)
+}
+
+func (cp CurvePolygonZM) String() string {
+	return wktString(cp)
+}
+
+func (cp CurvePolygonZM) MarshalText() ([]byte, error) {
+	return wkt(cp)
+}
+
+func (cp *CurvePolygonZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, cp)
+}
+
+func (mc MultiCurveZM) String() string {
+	return wktString(mc)
+}
+
+func (mc MultiCurveZM) MarshalText() ([]byte, error) {
+	return wkt(mc)
+}
+
+func (mc *MultiCurveZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, mc)
+}
+
+func (ms MultiSurfaceZM) String() string {
+	return wktString(ms)
+}
+
+func (ms MultiSurfaceZM) MarshalText() ([]byte, error) {
+	return wkt(ms)
+}
+
+func (ms *MultiSurfaceZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ms)
+}
+
+func (ps PolyhedralSurfaceZM) String() string {
+	return wktString(ps)
+}
+
+func (ps PolyhedralSurfaceZM) MarshalText() ([]byte, error) {
+	return wkt(ps)
+}
+
+func (ps *PolyhedralSurfaceZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, ps)
+}
+
+func (tin TINZM) String() string {
+	return wktString(tin)
+}
+
+func (tin TINZM) MarshalText() ([]byte, error) {
+	return wkt(tin)
+}
+
+func (tin *TINZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, tin)
+}
+
+func (t TriangleZM) String() string {
+	return wktString(t)
+}
+
+func (t TriangleZM) MarshalText() ([]byte, error) {
+	return wkt(t)
+}
+
+func (t *TriangleZM) UnmarshalText(text []byte) error {
+	return unmarshalWKT(text, t)
+}
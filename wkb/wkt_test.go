@@ -0,0 +1,205 @@
+package wkb
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWKT(t *testing.T) {
+	valid := []struct {
+		wkt string
+		g   Geometry
+	}{
+		{"POINT (30 10)", Point{30, 10}},
+		{"POINT Z (30 10 5)", PointZ{30, 10, 5}},
+		{"POINT M (30 10 2)", PointM{30, 10, 2}},
+		{"POINT ZM (30 10 5 2)", PointZM{30, 10, 5, 2}},
+		{"LINESTRING (30 10, 10 30, 40 40)", LineString{{30, 10}, {10, 30}, {40, 40}}},
+		{"LINESTRING Z (30 10 1, 10 30 2)", LineStringZ{{30, 10, 1}, {10, 30, 2}}},
+		{"LINESTRING EMPTY", LineString{}},
+		{
+			"POLYGON ((35 10, 45 45, 15 40, 10 20, 35 10), (20 30, 35 35, 30 20, 20 30))",
+			Polygon{
+				LinearRing{{35, 10}, {45, 45}, {15, 40}, {10, 20}, {35, 10}},
+				LinearRing{{20, 30}, {35, 35}, {30, 20}, {20, 30}},
+			},
+		},
+		{"POLYGON EMPTY", Polygon{}},
+		{"MULTIPOINT ((10 40), (40 30), (20 20), (30 10))", MultiPoint{{10, 40}, {40, 30}, {20, 20}, {30, 10}}},
+		{"MULTIPOINT M ((10 40 1), (40 30 2))", MultiPointM{{10, 40, 1}, {40, 30, 2}}},
+		{
+			"MULTILINESTRING ((10 10, 20 20, 10 40), (40 40, 30 30, 40 20, 30 10))",
+			MultiLineString{
+				LineString{{10, 10}, {20, 20}, {10, 40}},
+				LineString{{40, 40}, {30, 30}, {40, 20}, {30, 10}},
+			},
+		},
+		{
+			"MULTIPOLYGON (((30 20, 45 40, 10 40, 30 20)), ((15 5, 40 10, 10 20, 5 10, 15 5)))",
+			MultiPolygon{
+				Polygon{LinearRing{{30, 20}, {45, 40}, {10, 40}, {30, 20}}},
+				Polygon{LinearRing{{15, 5}, {40, 10}, {10, 20}, {5, 10}, {15, 5}}},
+			},
+		},
+		{
+			"MULTIPOLYGON ZM (((30 20 1 2, 45 40 1 2, 10 40 1 2, 30 20 1 2)))",
+			MultiPolygonZM{
+				PolygonZM{LinearRingZM{{30, 20, 1, 2}, {45, 40, 1, 2}, {10, 40, 1, 2}, {30, 20, 1, 2}}},
+			},
+		},
+		{
+			"GEOMETRYCOLLECTION (POINT (4 6), LINESTRING (4 6, 7 10))",
+			GeometryCollection{Point{4, 6}, LineString{{4, 6}, {7, 10}}},
+		},
+		{
+			"GEOMETRYCOLLECTION Z (POINT Z (4 6 1), LINESTRING Z (4 6 1, 7 10 2))",
+			GeometryCollectionZ{PointZ{4, 6, 1}, LineStringZ{{4, 6, 1}, {7, 10, 2}}},
+		},
+		{"GEOMETRYCOLLECTION EMPTY", GeometryCollection{}},
+		{"POINT (-1.5 0.000001)", Point{-1.5, 0.000001}},
+		{"CIRCULARSTRING (0 0, 1 1, 2 0)", CircularString{{0, 0}, {1, 1}, {2, 0}}},
+		{"CIRCULARSTRING Z (0 0 1, 1 1 2, 2 0 3)", CircularStringZ{{0, 0, 1}, {1, 1, 2}, {2, 0, 3}}},
+		{
+			"COMPOUNDCURVE (CIRCULARSTRING (0 0, 1 1, 2 0), (2 0, 3 0))",
+			CompoundCurve{CircularString{{0, 0}, {1, 1}, {2, 0}}, LineString{{2, 0}, {3, 0}}},
+		},
+		{
+			"CURVEPOLYGON (COMPOUNDCURVE (CIRCULARSTRING (0 0, 1 1, 2 0), (2 0, 0 0)), (0.5 0.2, 1 0.2, 1 0.5, 0.5 0.2))",
+			CurvePolygon{
+				CompoundCurve{CircularString{{0, 0}, {1, 1}, {2, 0}}, LineString{{2, 0}, {0, 0}}},
+				LineString{{0.5, 0.2}, {1, 0.2}, {1, 0.5}, {0.5, 0.2}},
+			},
+		},
+		{
+			"MULTICURVE M ((0 0 1, 1 0 2), CIRCULARSTRING M (0 0 1, 1 1 2, 2 0 3))",
+			MultiCurveM{LineStringM{{0, 0, 1}, {1, 0, 2}}, CircularStringM{{0, 0, 1}, {1, 1, 2}, {2, 0, 3}}},
+		},
+		{
+			"MULTISURFACE (((0 0, 1 0, 1 1, 0 0)), CURVEPOLYGON (CIRCULARSTRING (0 0, 2 0, 0 0)))",
+			MultiSurface{
+				Polygon{LinearRing{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+				CurvePolygon{CircularString{{0, 0}, {2, 0}, {0, 0}}},
+			},
+		},
+		{
+			"POLYHEDRALSURFACE Z (((0 0 0, 1 0 0, 0 1 0, 0 0 0)), ((0 0 0, 0 1 0, 0 0 1, 0 0 0)))",
+			PolyhedralSurfaceZ{
+				PolygonZ{LinearRingZ{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {0, 0, 0}}},
+				PolygonZ{LinearRingZ{{0, 0, 0}, {0, 1, 0}, {0, 0, 1}, {0, 0, 0}}},
+			},
+		},
+		{"TIN (((0 0, 1 0, 0 1, 0 0)))", TIN{Triangle{LinearRing{{0, 0}, {1, 0}, {0, 1}, {0, 0}}}}},
+		{"TRIANGLE ZM ((0 0 1 2, 1 0 1 2, 0 1 1 2, 0 0 1 2))", TriangleZM{LinearRingZM{{0, 0, 1, 2}, {1, 0, 1, 2}, {0, 1, 1, 2}, {0, 0, 1, 2}}}},
+	}
+
+	for _, e := range valid {
+		if g, err := ParseWKT(e.wkt); assert.NoError(t, err, e.wkt) {
+			assert.Equal(t, e.g, g)
+		}
+
+		if text, err := e.g.(interface {
+			MarshalText() ([]byte, error)
+		}).MarshalText(); assert.NoError(t, err) {
+			assert.Equal(t, e.wkt, string(text))
+		}
+	}
+
+	lenient := map[string]Geometry{
+		"point(30 10)":                      Point{30, 10},
+		"POINTZ(30 10 5)":                   PointZ{30, 10, 5},
+		"POINT (30 10 5)":                   PointZ{30, 10, 5},
+		"POINT (30 10 5 2)":                 PointZM{30, 10, 5, 2},
+		"MULTIPOINT (10 40, 40 30)":         MultiPoint{{10, 40}, {40, 30}},
+		"GEOMETRYCOLLECTION(POINT(1 2 3))":  GeometryCollectionZ{PointZ{1, 2, 3}},
+		"\tLINESTRING ( 1e1 2 ,\n3 -4e-1 )": LineString{{10, 2}, {3, -0.4}},
+	}
+
+	for s, expected := range lenient {
+		if g, err := ParseWKT(s); assert.NoError(t, err, s) {
+			assert.Equal(t, expected, g)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"POINT",
+		"POINT (30)",
+		"POINT (30 10",
+		"POINT (30 10) POINT",
+		"POINT Z (30 10)",
+		"POINT (30 foo)",
+		"LINESTRING (30 10, 10 30 5)",
+		"CIRCLE (30 10)",
+		"POLYGON (30 10, 10 30)",
+		"MULTIPOINT ((10 40), )",
+	}
+
+	for _, s := range invalid {
+		if _, err := ParseWKT(s); assert.Error(t, err, s) {
+			assert.ErrorIs(t, err, ErrInvalidWKT)
+		}
+	}
+
+	_, err := ParseWKT("CIRCULARSTRING (0 0, 1 1)")
+	assert.ErrorIs(t, err, ErrInvalidStorage)
+}
+
+func TestWKTEmptyPoint(t *testing.T) {
+	g, err := ParseWKT("POINT EMPTY")
+	if assert.NoError(t, err) {
+		p := g.(Point)
+		assert.True(t, math.IsNaN(p.X) && math.IsNaN(p.Y))
+		assert.Equal(t, "POINT EMPTY", p.String())
+	}
+
+	g, err = ParseWKT("MULTIPOINT Z (EMPTY, (1 2 3))")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "MULTIPOINT Z (EMPTY, (1 2 3))", g.(MultiPointZ).String())
+	}
+
+	// dimension of empty members preceding first coordinate is inferred from it
+	inferred := map[string]string{
+		"MULTIPOINT (EMPTY, (1 2 3))":                              "MULTIPOINT Z (EMPTY, (1 2 3))",
+		"MULTILINESTRING (EMPTY, (1 2 3, 4 5 6))":                  "MULTILINESTRING Z (EMPTY, (1 2 3, 4 5 6))",
+		"GEOMETRYCOLLECTION (POINT EMPTY, POINT (1 2 3 4))":        "GEOMETRYCOLLECTION ZM (POINT ZM EMPTY, POINT ZM (1 2 3 4))",
+		"GEOMETRYCOLLECTION (LINESTRING EMPTY, POINT M EMPTY)":     "GEOMETRYCOLLECTION M (LINESTRING M EMPTY, POINT M EMPTY)",
+		"MULTICURVE (EMPTY, CIRCULARSTRING (0 0 1, 1 1 1, 2 0 1))": "MULTICURVE Z (EMPTY, CIRCULARSTRING Z (0 0 1, 1 1 1, 2 0 1))",
+	}
+	for s, expected := range inferred {
+		if g, err := ParseWKT(s); assert.NoError(t, err, s) {
+			assert.Equal(t, expected, g.(fmt.Stringer).String())
+		}
+	}
+}
+
+func TestWKTDepth(t *testing.T) {
+	nested := func(depth int) string {
+		return strings.Repeat("GEOMETRYCOLLECTION (", depth-1) + "POINT (1 2)" + strings.Repeat(")", depth-1)
+	}
+
+	_, err := ParseWKT(nested(DefaultLimits.MaxDepth))
+	assert.NoError(t, err)
+	_, err = ParseWKT(nested(DefaultLimits.MaxDepth + 1))
+	assert.ErrorIs(t, err, ErrLimitExceeded)
+}
+
+func TestUnmarshalText(t *testing.T) {
+	p := Polygon{}
+	if assert.NoError(t, p.UnmarshalText([]byte("POLYGON ((30 10, 40 40, 20 40, 10 20, 30 10))"))) {
+		assert.Equal(t, Polygon{
+			LinearRing{{30, 10}, {40, 40}, {20, 40}, {10, 20}, {30, 10}},
+		}, p)
+	}
+
+	if err := p.UnmarshalText([]byte("POINT (30 10)")); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrUnsupportedValue)
+	}
+
+	if err := p.UnmarshalText([]byte("POLYGON (")); assert.Error(t, err) {
+		assert.ErrorIs(t, err, ErrInvalidWKT)
+	}
+}